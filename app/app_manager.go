@@ -2,38 +2,80 @@ package app
 
 import (
 	"errors"
+	"fmt"
+	"os"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/olebedev/config"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/notify"
+	"github.com/wtfutil/wtf/state"
+)
+
+const (
+	// appStateScope names the UI state that belongs to the whole app rather than a dashboard
+	appStateScope = "app"
+
+	// dashboardStateKey names the displayed dashboard in the app's UI state
+	dashboardStateKey = "dashboard"
 )
 
 // WtfAppManager handles the instances of WtfApp, ensuring that they're displayed as requested
 type WtfAppManager struct {
-	WtfApps []*WtfApp
+	TViewApp *tview.Application
+	WtfApps  []*WtfApp
 
-	selected int
+	configFilePath string
+	selected       int
 }
 
-// NewAppManager creates and returns an instance of AppManager
-func NewAppManager() WtfAppManager {
-	appMan := WtfAppManager{
-		WtfApps: []*WtfApp{},
+// NewAppManager creates and returns an instance of AppManager. configFilePath is the main
+// config file, whose global settings apply to every dashboard
+func NewAppManager(configFilePath string) *WtfAppManager {
+	appMan := &WtfAppManager{
+		TViewApp: tview.NewApplication(),
+		WtfApps:  []*WtfApp{},
+
+		configFilePath: configFilePath,
 	}
 
+	appMan.TViewApp.SetInputCapture(appMan.keyboardIntercept)
+	appMan.TViewApp.SetMouseCapture(appMan.mouseIntercept)
+
+	// Alerts write to the terminal between draws, so they don't land in the middle of one
+	notify.SetTerminal(func(sequence string) {
+		appMan.TViewApp.QueueUpdate(func() {
+			_, _ = os.Stdout.WriteString(sequence)
+		})
+	})
+
 	return appMan
 }
 
-// MakeNewWtfApp creates and starts a new instance of WtfApp from a set of configuration params
-func (appMan *WtfAppManager) MakeNewWtfApp(config *config.Config, configFilePath string) {
-	wtfApp := NewWtfApp(tview.NewApplication(), config, configFilePath)
+// MakeNewWtfApp creates and starts a new instance of WtfApp from a set of configuration params.
+// The first app made is the one displayed, any others start paused in the background. The
+// first app's config also decides whether the mouse is used
+func (appMan *WtfAppManager) MakeNewWtfApp(name string, config *config.Config, configFilePath string) {
+	wtfApp := NewWtfApp(appMan.TViewApp, config, configFilePath)
+	wtfApp.name = name
+
 	appMan.Add(wtfApp)
 
+	if len(appMan.WtfApps) == 1 {
+		appMan.TViewApp.EnableMouse(config.UBool("wtf.mouse", false))
+		appMan.display(wtfApp)
+	} else {
+		wtfApp.Pause()
+	}
+
 	wtfApp.Start()
 }
 
 // Add adds a WtfApp to the collection of apps that the AppManager manages.
 // This app is then available for display onscreen.
 func (appMan *WtfAppManager) Add(wtfApp *WtfApp) {
+	wtfApp.appManager = appMan
 	appMan.WtfApps = append(appMan.WtfApps, wtfApp)
 }
 
@@ -46,6 +88,18 @@ func (appMan *WtfAppManager) Current() (*WtfApp, error) {
 	return appMan.WtfApps[appMan.selected], nil
 }
 
+// Execute starts the underlying tview app, displaying the dashboard that was displayed
+// before the last restart, or else the current WtfApp
+func (appMan *WtfAppManager) Execute() error {
+	if _, err := appMan.Current(); err != nil {
+		return err
+	}
+
+	appMan.restoreDashboard()
+
+	return appMan.TViewApp.Run()
+}
+
 // Next cycles the WtfApps forward by one, making the next one in the list
 // the current one. If there are none after the current one, it wraps around.
 func (appMan *WtfAppManager) Next() (*WtfApp, error) {
@@ -69,3 +123,90 @@ func (appMan *WtfAppManager) Prev() (*WtfApp, error) {
 
 	return appMan.Current()
 }
+
+// Select makes the WtfApp at the given index the current one, pausing the widgets of
+// the previously-displayed app and resuming the widgets of the newly-displayed one
+func (appMan *WtfAppManager) Select(idx int) {
+	if idx < 0 || idx >= len(appMan.WtfApps) || idx == appMan.selected {
+		return
+	}
+
+	if current, err := appMan.Current(); err == nil {
+		current.Pause()
+	}
+
+	appMan.selected = idx
+
+	wtfApp, _ := appMan.Current()
+	appMan.display(wtfApp)
+	wtfApp.Resume()
+
+	state.Save(appStateScope, dashboardStateKey, wtfApp.name)
+}
+
+// Stop kills all the currently-running widgets in all the apps, and writes the UI state
+// that hasn't been written yet
+func (appMan *WtfAppManager) Stop() {
+	for _, wtfApp := range appMan.WtfApps {
+		wtfApp.Stop()
+	}
+
+	if err := state.Flush(); err != nil {
+		logger.Warn(fmt.Sprintf("The UI state can't be saved: %s", err))
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (appMan *WtfAppManager) display(wtfApp *WtfApp) {
+	appMan.TViewApp.SetRoot(wtfApp.pages, true)
+	wtfApp.focusTracker.Refocus()
+}
+
+func (appMan *WtfAppManager) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
+	current, err := appMan.Current()
+	if err != nil {
+		return event
+	}
+
+	// While the dashboard picker or command palette is open, it gets all the key presses
+	if current.pages.HasPage(dashboardPickerPage) || current.pages.HasPage(commandPalettePage) {
+		return event
+	}
+
+	switch current.keys.action(event, current.focusTracker.IsFocused) {
+	case actionNextDashboard:
+		appMan.Select((appMan.selected + 1) % len(appMan.WtfApps))
+		return nil
+	case actionPickDashboard:
+		appMan.showDashboardPicker()
+		return nil
+	}
+
+	return current.keyboardIntercept(event)
+}
+
+func (appMan *WtfAppManager) mouseIntercept(event *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+	current, err := appMan.Current()
+	if err != nil {
+		return event, action
+	}
+
+	return current.mouseIntercept(event, action)
+}
+
+// restoreDashboard selects the dashboard that was displayed before the last restart, if
+// it's still around
+func (appMan *WtfAppManager) restoreDashboard() {
+	name := ""
+	if !state.Load(appStateScope, dashboardStateKey, &name) {
+		return
+	}
+
+	for idx, wtfApp := range appMan.WtfApps {
+		if wtfApp.name == name {
+			appMan.Select(idx)
+			return
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	commandPalettePage   = "palette"
+	commandPaletteRows   = 12
+	commandPaletteWidth  = 70
+	commandPaletteBorder = 3
+)
+
+// paletteEntry is something that can be run from the command palette
+type paletteEntry struct {
+	keys  []string
+	label string
+	run   func()
+}
+
+// showCommandPalette displays an overlay that fuzzy-searches the widgets, to focus them,
+// and every widget's keyboard commands, to run them on that widget
+func (wtfApp *WtfApp) showCommandPalette() {
+	if wtfApp.pages.HasPage(commandPalettePage) {
+		return
+	}
+
+	entries := wtfApp.paletteEntries()
+	labels := make([]string, len(entries))
+	for idx, entry := range entries {
+		labels[idx] = entry.label
+	}
+
+	matches := []int{}
+
+	closeFunc := func() {
+		wtfApp.pages.RemovePage(commandPalettePage)
+		wtfApp.focusTracker.Refocus()
+		if !wtfApp.focusTracker.IsFocused {
+			wtfApp.TViewApp.SetFocus(wtfApp.pages)
+		}
+	}
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetHighlightFullLine(true)
+
+	filter := func(query string) {
+		matches = fuzzyFilter(query, labels)
+
+		list.Clear()
+		for _, idx := range matches {
+			list.AddItem(paletteItemText(entries[idx]), "", 0, nil)
+		}
+	}
+
+	input := tview.NewInputField()
+	input.SetLabel(": ")
+	input.SetFieldBackgroundColor(tcell.ColorDefault)
+	input.SetChangedFunc(filter)
+	input.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+		case tcell.KeyEnter:
+			if len(matches) == 0 {
+				return nil
+			}
+
+			entry := entries[matches[list.GetCurrentItem()]]
+			closeFunc()
+			entry.run()
+		case tcell.KeyDown, tcell.KeyCtrlN, tcell.KeyTab:
+			if list.GetItemCount() > 0 {
+				list.SetCurrentItem((list.GetCurrentItem() + 1) % list.GetItemCount())
+			}
+		case tcell.KeyUp, tcell.KeyCtrlP, tcell.KeyBacktab:
+			if list.GetItemCount() > 0 {
+				list.SetCurrentItem((list.GetCurrentItem() - 1 + list.GetItemCount()) % list.GetItemCount())
+			}
+		default:
+			return event
+		}
+
+		return nil
+	})
+
+	filter("")
+
+	layout := tview.NewFlex()
+	layout.SetDirection(tview.FlexRow)
+	layout.AddItem(input, 1, 0, true)
+	layout.AddItem(list, 0, 1, false)
+
+	frame := tview.NewFrame(layout)
+	frame.SetBorder(true)
+	frame.SetBorders(0, 0, 0, 0, 1, 1)
+	frame.SetTitle(" Command Palette ")
+
+	height := commandPaletteRows + commandPaletteBorder
+	frame.SetDrawFunc(func(screen tcell.Screen, x, y, width, _ int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(commandPaletteWidth/2), (h/2)-(height/2), commandPaletteWidth, height)
+		return x, y, width, height
+	})
+	frame.SetRect(0, 0, commandPaletteWidth, height)
+
+	wtfApp.pages.AddPage(commandPalettePage, frame, false, true)
+	wtfApp.TViewApp.SetFocus(input)
+}
+
+// paletteEntries lists everything the command palette can do: focus each focusable
+// widget, run each widget's keyboard commands, and run the app-wide commands
+func (wtfApp *WtfApp) paletteEntries() []paletteEntry {
+	entries := []paletteEntry{}
+
+	for _, widget := range wtfApp.focusTracker.focusables() {
+		name := widget.Name()
+
+		entries = append(entries, paletteEntry{
+			label: widgetTitle(widget),
+			run:   func() { wtfApp.focusTracker.FocusOnName(name) },
+		})
+	}
+
+	for _, widget := range wtfApp.widgets {
+		entries = append(entries, wtfApp.actionEntries(widget, widget)...)
+	}
+
+	globalFuncs := wtfApp.globalActionFuncs()
+	for _, globalAction := range GlobalKeyActions() {
+		run, ok := globalFuncs[globalAction.Action]
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, paletteEntry{
+			keys:  wtfApp.keys.keysFor(globalAction.Action),
+			label: "WTF: " + strings.ToLower(globalAction.Help),
+			run:   run,
+		})
+	}
+
+	return entries
+}
+
+// actionEntries lists the keyboard commands of the widget and, if it's a container such as
+// a group, those of the widgets inside it. The top-level widget is the one that's focused
+// to run them
+func (wtfApp *WtfApp) actionEntries(widget wtf.Wtfable, topLevel wtf.Wtfable) []paletteEntry {
+	entries := []paletteEntry{}
+
+	if actionable, ok := widget.(wtf.Actionable); ok {
+		name := topLevel.Name()
+		focusable := topLevel.Focusable()
+
+		for _, action := range actionable.Actions() {
+			run := action.Run
+
+			entries = append(entries, paletteEntry{
+				keys:  action.Keys,
+				label: fmt.Sprintf("%s: %s", widgetTitle(widget), strings.ToLower(action.Help)),
+				run: func() {
+					// Commands act on the focused widget, and some of them open modals that
+					// hand focus back to it when they close
+					if focusable {
+						wtfApp.focusTracker.FocusOnName(name)
+					}
+					run()
+				},
+			})
+		}
+	}
+
+	if container, ok := widget.(wtf.Container); ok {
+		for _, child := range container.Children() {
+			entries = append(entries, wtfApp.actionEntries(child, topLevel)...)
+		}
+	}
+
+	return entries
+}
+
+// paletteItemText is how an entry is displayed in the command palette's list
+func paletteItemText(entry paletteEntry) string {
+	text := tview.Escape(entry.label)
+	if len(entry.keys) > 0 {
+		text += fmt.Sprintf("  [::d]%s[::-]", tview.Escape(strings.Join(entry.keys, ", ")))
+	}
+
+	return text
+}
+
+// widgetTitle is the name a widget is listed under in the command palette
+func widgetTitle(widget wtf.Wtfable) string {
+	title := strings.TrimSpace(widget.CommonSettings().Title)
+	if title == "" {
+		return widget.Name()
+	}
+
+	return title
+}
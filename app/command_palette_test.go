@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_paletteEntries(t *testing.T) {
+	cfg, _ := config.ParseYaml(headlessConfig + `
+      focusable: true`)
+
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+	defer wtfApp.Stop()
+
+	labels := []string{}
+	for _, entry := range wtfApp.paletteEntries() {
+		labels = append(labels, paletteItemText(entry))
+	}
+
+	assert.Contains(t, labels, "World Clocks")
+	assert.Contains(t, labels, "World Clocks: open the documentation for this module in a browser  [::d]\\[::-]")
+	assert.Contains(t, labels, "WTF: refresh every widget  [::d]Ctrl-R[::-]")
+	assert.NotContains(t, labels, "WTF: search the widgets and their commands  [::d]:, Ctrl-P[::-]")
+}
+
+func Test_paletteEntries_group(t *testing.T) {
+	cfg, _ := config.ParseYaml(`
+wtf:
+  mods:
+    times:
+      type: group
+      enabled: true
+      title: "Times"
+      mods:
+        utc:
+          type: clocks
+          title: "UTC Clock"
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`)
+
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+	defer wtfApp.Stop()
+
+	labels := []string{}
+	for _, entry := range wtfApp.paletteEntries() {
+		labels = append(labels, paletteItemText(entry))
+	}
+
+	assert.Contains(t, labels, "Times: select next tab  [::d]][::-]")
+	assert.Contains(t, labels, "UTC Clock: open the documentation for this module in a browser  [::d]\\[::-]")
+}
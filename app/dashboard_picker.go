@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	dashboardPickerPage   = "dashboards"
+	dashboardPickerWidth  = 40
+	dashboardPickerBorder = 4
+)
+
+// showDashboardPicker displays a modal list of all the dashboards so that one can be
+// selected and brought onscreen
+func (appMan *WtfAppManager) showDashboardPicker() {
+	current, err := appMan.Current()
+	if err != nil {
+		return
+	}
+
+	closeFunc := func() {
+		current.pages.RemovePage(dashboardPickerPage)
+		appMan.display(current)
+	}
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetHighlightFullLine(true)
+
+	for idx, wtfApp := range appMan.WtfApps {
+		selectedIdx := idx
+
+		shortcut := rune(0)
+		if idx < 9 {
+			shortcut = rune('1' + idx)
+		}
+
+		list.AddItem(wtfApp.name, "", shortcut, func() {
+			current.pages.RemovePage(dashboardPickerPage)
+			if selectedIdx == appMan.selected {
+				appMan.display(current)
+				return
+			}
+			appMan.Select(selectedIdx)
+		})
+	}
+
+	list.SetCurrentItem(appMan.selected)
+	list.SetDoneFunc(closeFunc)
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if current.keys.action(event, false) == actionPickDashboard {
+			closeFunc()
+			return nil
+		}
+		return event
+	})
+
+	frame := tview.NewFrame(list)
+	frame.SetBorder(true)
+	frame.SetBorders(0, 0, 0, 0, 1, 1)
+	frame.SetTitle(fmt.Sprintf(" Dashboards (%d) ", len(appMan.WtfApps)))
+
+	height := len(appMan.WtfApps) + dashboardPickerBorder
+	frame.SetDrawFunc(func(screen tcell.Screen, x, y, width, _ int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(dashboardPickerWidth/2), (h/2)-(height/2), dashboardPickerWidth, height)
+		return x, y, width, height
+	})
+	frame.SetRect(0, 0, dashboardPickerWidth, height)
+
+	current.pages.AddPage(dashboardPickerPage, frame, false, true)
+	appMan.TViewApp.SetFocus(list)
+}
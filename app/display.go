@@ -1,16 +1,31 @@
 package app
 
 import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
 	"github.com/olebedev/config"
 	"github.com/rivo/tview"
-	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/wtf"
 )
 
+// gridPage is the page the grid of widgets is displayed on
+const gridPage = "grid"
+
 // Display is the container for the onscreen representation of a WtfApp
 type Display struct {
 	Grid   *tview.Grid
 	config *config.Config
+
+	fallback layout
+	layouts  []layout
+	visible  []wtf.Wtfable
+	widgets  []wtf.Wtfable
+
+	// The layout the grid was last arranged for, and the width it was arranged at
+	current      string
+	currentWidth int
 }
 
 // NewDisplay creates and returns a Display
@@ -34,34 +49,84 @@ func NewDisplay(widgets []wtf.Wtfable, config *config.Config) *Display {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (display *Display) add(widget wtf.Wtfable) {
-	if widget.Disabled() {
+// arrange lays the widgets out for a screen of the given size, switching to the layout
+// whose breakpoints it falls within. Flow layouts are also rearranged when the width
+// changes, as that changes how many columns fit
+func (display *Display) arrange(width, height int) {
+	layout := selectLayout(display.layouts, display.fallback, width, height)
+
+	if layout.name == display.current && (!layout.flow || width == display.currentWidth) {
 		return
 	}
 
-	display.Grid.AddItem(
-		widget.TextView(),
-		widget.CommonSettings().Top,
-		widget.CommonSettings().Left,
-		widget.CommonSettings().Height,
-		widget.CommonSettings().Width,
-		0,
-		0,
-		false,
-	)
-}
+	if display.current != "" && layout.name != display.current {
+		logger.Info(fmt.Sprintf("Switching to the %s layout at %dx%d", layout.name, width, height))
+	}
 
-func (display *Display) build(widgets []wtf.Wtfable) *tview.Grid {
-	cols := utils.ToInts(display.config.UList("wtf.grid.columns"))
-	rows := utils.ToInts(display.config.UList("wtf.grid.rows"))
+	display.current = layout.name
+	display.currentWidth = width
+
+	columns, rows, placements := layout.place(display.widgets, width)
 
-	display.Grid.SetColumns(cols...)
+	display.Grid.Clear()
+	display.Grid.SetColumns(columns...)
 	display.Grid.SetRows(rows...)
-	display.Grid.SetBorder(false)
 
+	display.visible = []wtf.Wtfable{}
+
+	placed := map[string]bool{}
+	for _, placement := range placements {
+		display.Grid.AddItem(
+			placement.widget.TextView(),
+			placement.top,
+			placement.left,
+			placement.height,
+			placement.width,
+			0,
+			0,
+			false,
+		)
+		placed[placement.widget.Name()] = true
+		display.visible = append(display.visible, placement.widget)
+	}
+
+	for _, widget := range display.widgets {
+		if !placed[widget.Name()] {
+			logger.Module(widget.Name()).Warn(fmt.Sprintf("Not displayed, it has no position in the %s layout", layout.name))
+		}
+	}
+}
+
+// widgetAt returns the widget displayed at the given screen position, or nil if there's
+// none there. Widgets the current layout leaves out aren't drawn, so they're skipped
+func (display *Display) widgetAt(x, y int) wtf.Wtfable {
+	for _, widget := range display.visible {
+		if widget.TextView().InRect(x, y) {
+			return widget
+		}
+	}
+
+	return nil
+}
+
+func (display *Display) build(widgets []wtf.Wtfable) *tview.Grid {
+	display.layouts, display.fallback = newLayouts(display.config)
+
+	display.widgets = []wtf.Wtfable{}
 	for _, widget := range widgets {
-		display.add(widget)
+		if !widget.Disabled() {
+			display.widgets = append(display.widgets, widget)
+		}
 	}
 
+	display.Grid.SetBorder(false)
+
+	// The layout depends on the size of the screen, so the widgets are arranged just
+	// before they're drawn, which is also when a resize is noticed
+	display.Grid.SetDrawFunc(func(_ tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		display.arrange(width, height)
+		return x, y, width, height
+	})
+
 	return display.Grid
 }
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const errorOverlayPage = "errors"
+
+// showErrorOverlay displays a modal listing every widget whose most recent refresh
+// failed, along with its error and when it last succeeded. Only modules that report
+// their refresh errors with SetRefreshError are listed; modules that don't fetch
+// anything, or that only show their errors in their own content, never appear
+func (wtfApp *WtfApp) showErrorOverlay() {
+	if wtfApp.pages.HasPage(errorOverlayPage) {
+		return
+	}
+
+	closeFunc := func() {
+		wtfApp.pages.RemovePage(errorOverlayPage)
+		wtfApp.focusTracker.Refocus()
+		if !wtfApp.focusTracker.IsFocused {
+			wtfApp.TViewApp.SetFocus(wtfApp.pages)
+		}
+	}
+
+	modal := view.NewBillboardModal(errorOverlayText(expandContainers(wtfApp.widgets), cfg.NewGlobalRoleTheme(wtfApp.config)), closeFunc)
+
+	wtfApp.pages.AddPage(errorOverlayPage, modal, false, true)
+	wtfApp.TViewApp.SetFocus(modal)
+}
+
+// errorOverlayText builds the contents of the error overlay
+func errorOverlayText(widgets []wtf.Wtfable, roles cfg.RoleTheme) string {
+	failing := []wtf.Wtfable{}
+	for _, widget := range widgets {
+		if widget.RefreshError() != nil {
+			failing = append(failing, widget)
+		}
+	}
+
+	if len(failing) == 0 {
+		return fmt.Sprintf(" [%s::b]No widgets are reporting errors[-::-]\n\n Only modules that report their refresh errors are listed here\n", roles.Ok)
+	}
+
+	sort.Slice(failing, func(i, j int) bool {
+		return failing[i].Name() < failing[j].Name()
+	})
+
+	str := fmt.Sprintf(" [%s::b]%d failing widget(s)[-::-]\n\n", roles.Error, len(failing))
+
+	for _, widget := range failing {
+		lastSuccess := "never"
+		if at := widget.LastSuccessAt(); !at.IsZero() {
+			lastSuccess = at.Format(time.Stamp)
+		}
+
+		str += fmt.Sprintf(
+			" [%s]%s[-] (%s)\n   failures: %d, last success: %s\n   %s\n\n",
+			roles.Warn,
+			widget.Name(),
+			widget.CommonSettings().Type,
+			widget.ConsecutiveFailures(),
+			lastSuccess,
+			strings.TrimSpace(widget.RefreshError().Error()),
+		)
+	}
+
+	return str
+}
@@ -6,9 +6,13 @@ import (
 
 	"github.com/olebedev/config"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/state"
 	"github.com/wtfutil/wtf/wtf"
 )
 
+// focusedStateKey names the focused widget in the dashboard's UI state
+const focusedStateKey = "focused"
+
 // FocusState is a custom type that differentiates focusable scopes
 type FocusState int
 
@@ -25,19 +29,22 @@ type FocusTracker struct {
 	IsFocused bool
 	Widgets   []wtf.Wtfable
 
-	config   *config.Config
-	tviewApp *tview.Application
+	config     *config.Config
+	stateScope string
+	tviewApp   *tview.Application
 }
 
-// NewFocusTracker creates and returns an instance of FocusTracker
-func NewFocusTracker(tviewApp *tview.Application, widgets []wtf.Wtfable, config *config.Config) FocusTracker {
+// NewFocusTracker creates and returns an instance of FocusTracker. The focused widget is
+// remembered in the UI state of the dashboard loaded from configFilePath
+func NewFocusTracker(tviewApp *tview.Application, widgets []wtf.Wtfable, config *config.Config, configFilePath string) FocusTracker {
 	focusTracker := FocusTracker{
 		tviewApp:  tviewApp,
 		Idx:       -1,
 		IsFocused: false,
 		Widgets:   widgets,
 
-		config: config,
+		config:     config,
+		stateScope: dashboardStateScope(configFilePath),
 	}
 
 	focusTracker.assignHotKeys()
@@ -74,6 +81,22 @@ func (tracker *FocusTracker) FocusOn(char string) bool {
 	return hasFocusable
 }
 
+// FocusOnName puts the focus on the focusable widget with the given module name
+func (tracker *FocusTracker) FocusOnName(name string) bool {
+	for idx, focusable := range tracker.focusables() {
+		if focusable.Name() == name {
+			tracker.blur(tracker.Idx)
+			tracker.Idx = idx
+			tracker.focus(tracker.Idx)
+
+			tracker.IsFocused = true
+			return true
+		}
+	}
+
+	return false
+}
+
 // Next sets the focus on the next widget in the widget list. If the current widget is
 // the last widget, sets focus on the first widget.
 func (tracker *FocusTracker) Next() {
@@ -118,6 +141,11 @@ func (tracker *FocusTracker) Refocus() {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// dashboardStateScope names the UI state of the dashboard loaded from configFilePath
+func dashboardStateScope(configFilePath string) string {
+	return "dashboard:" + configFilePath
+}
+
 // AssignHotKeys assigns an alphabetic keyboard character to each focusable
 // widget so that the widget can be brought into focus by pressing that keyboard key
 // Valid numbers are between 1 and 9, inclusive
@@ -178,6 +206,8 @@ func (tracker *FocusTracker) blur(idx int) {
 	)
 
 	tracker.IsFocused = false
+
+	state.Save(tracker.stateScope, focusedStateKey, "")
 }
 
 func (tracker *FocusTracker) decrement() {
@@ -201,6 +231,17 @@ func (tracker *FocusTracker) focus(idx int) {
 		),
 	)
 	tracker.tviewApp.SetFocus(view)
+
+	state.Save(tracker.stateScope, focusedStateKey, widget.Name())
+}
+
+// focused returns the widget that has focus, or nil if none does
+func (tracker *FocusTracker) focused() wtf.Wtfable {
+	if tracker.focusState() != widgetFocused {
+		return nil
+	}
+
+	return tracker.focusableAt(tracker.Idx)
 }
 
 func (tracker *FocusTracker) focusables() []wtf.Wtfable {
@@ -259,6 +300,23 @@ func (tracker *FocusTracker) increment() {
 	}
 }
 
+// restoreFocus selects the widget that had focus before the last restart, which gets the
+// focus when the app is displayed and Refocus is called
+func (tracker *FocusTracker) restoreFocus() {
+	name := ""
+	if !state.Load(tracker.stateScope, focusedStateKey, &name) || name == "" {
+		return
+	}
+
+	for idx, focusable := range tracker.focusables() {
+		if focusable.Name() == name {
+			tracker.Idx = idx
+			tracker.IsFocused = true
+			return
+		}
+	}
+}
+
 func (tracker *FocusTracker) useNavShortcuts() bool {
 	return tracker.config.UBool("wtf.navigation.shortcuts", true)
 }
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Scores for the fuzzy matcher. Matches that run on from the previous match, or that
+// start a word, count for more so that "opr" prefers "Open Pull Requests" over "zoo pair"
+const (
+	fuzzyConsecutiveBonus = 5
+	fuzzyMatchScore       = 1
+	fuzzyWordStartBonus   = 3
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fuzzyScore reports whether every character of the query appears in the candidate, in
+// order, and how good a match it is. Matching ignores case, and spaces in the query are
+// ignored so that "gh pr" matches "GitHub: open pull requests"
+func fuzzyScore(query, candidate string) (int, bool) {
+	needle := []rune(strings.ToLower(strings.ReplaceAll(query, " ", "")))
+	haystack := []rune(strings.ToLower(candidate))
+
+	score := 0
+	last := -2
+	pos := 0
+
+	for _, char := range needle {
+		found := false
+
+		for ; pos < len(haystack); pos++ {
+			if haystack[pos] != char {
+				continue
+			}
+
+			score += fuzzyMatchScore
+			if pos == last+1 {
+				score += fuzzyConsecutiveBonus
+			}
+			if pos == 0 || !isWordChar(haystack[pos-1]) {
+				score += fuzzyWordStartBonus
+			}
+
+			last = pos
+			pos++
+			found = true
+			break
+		}
+
+		if !found {
+			return 0, false
+		}
+	}
+
+	return score, true
+}
+
+// fuzzyFilter returns the indexes of the candidates that match the query, best matches
+// first. Of equally good matches the shorter one comes first, so that a widget's title is
+// listed before its commands, otherwise they keep their original order. An empty query
+// matches every candidate, in their original order
+func fuzzyFilter(query string, candidates []string) []int {
+	if strings.TrimSpace(query) == "" {
+		indexes := make([]int, len(candidates))
+		for i := range candidates {
+			indexes[i] = i
+		}
+		return indexes
+	}
+
+	type match struct {
+		idx    int
+		length int
+		score  int
+	}
+
+	matches := []match{}
+	for idx, candidate := range candidates {
+		if score, ok := fuzzyScore(query, candidate); ok {
+			matches = append(matches, match{idx: idx, length: len(candidate), score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].length < matches[j].length
+	})
+
+	indexes := make([]int, len(matches))
+	for i, m := range matches {
+		indexes[i] = m.idx
+	}
+
+	return indexes
+}
+
+func isWordChar(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsNumber(char)
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_fuzzyScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		candidate string
+		matches   bool
+	}{
+		{"empty query", "", "GitHub", true},
+		{"prefix", "git", "GitHub", true},
+		{"ignores case", "GITHUB", "GitHub", true},
+		{"subsequence", "gh pr", "GitHub: open pull requests", true},
+		{"out of order", "hg", "GitHub", false},
+		{"missing character", "gitx", "GitHub", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := fuzzyScore(tt.query, tt.candidate)
+			assert.Equal(t, tt.matches, ok)
+		})
+	}
+}
+
+func Test_fuzzyFilter(t *testing.T) {
+	candidates := []string{
+		"Zoo pair",
+		"Todo: new item",
+		"GitHub: open pull requests",
+		"GitHub",
+	}
+
+	assert.Equal(t, []int{2, 0}, fuzzyFilter("opr", candidates))
+	assert.Equal(t, []int{3, 2}, fuzzyFilter("github", candidates))
+	assert.Equal(t, []int{0, 1, 2, 3}, fuzzyFilter("", candidates))
+	assert.Equal(t, []int{}, fuzzyFilter("xyz", candidates))
+}
@@ -0,0 +1,236 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	// DefaultHeadlessAddress is the address the headless app listens on if none is configured
+	DefaultHeadlessAddress = "localhost:7007"
+
+	headlessWidgetsPath = "/api/widgets"
+)
+
+// HeadlessApp runs the widgets defined by one or more configuration files without a
+// terminal, and serves their most recently rendered data as JSON over HTTP
+type HeadlessApp struct {
+	TViewApp *tview.Application
+
+	dashboards []headlessDashboard
+	redrawChan chan bool
+}
+
+type headlessDashboard struct {
+	name    string
+	widgets []wtf.Wtfable
+}
+
+// WidgetState is the JSON representation of a widget's most recently rendered data
+type WidgetState struct {
+	Dashboard   string     `json:"dashboard"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Title       string     `json:"title"`
+	Content     string     `json:"content"`
+	RefreshedAt *time.Time `json:"refreshedAt"`
+	CachedAt    *time.Time `json:"cachedAt,omitempty"`
+	Position    struct {
+		Top    int `json:"top"`
+		Left   int `json:"left"`
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"position"`
+}
+
+// NewHeadlessApp creates and returns an instance of HeadlessApp. The tview application
+// it creates is never run, it only exists because the widgets expect one
+func NewHeadlessApp() *HeadlessApp {
+	headlessApp := &HeadlessApp{
+		TViewApp: tview.NewApplication(),
+
+		redrawChan: make(chan bool, 1),
+	}
+
+	// The application's event loop never runs, so the widgets' updates can't wait for it
+	view.RunUpdatesDirectly(headlessApp.TViewApp)
+
+	// Nothing is drawn to a screen, but widgets still signal that they need a redraw
+	go func() {
+		for range headlessApp.redrawChan {
+		}
+	}()
+
+	return headlessApp
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// AddDashboard creates the widgets for the configuration loaded from configFilePath and
+// schedules their refreshes
+func (headlessApp *HeadlessApp) AddDashboard(name string, config *config.Config, configFilePath string) {
+	widgets := MakeWidgets(headlessApp.TViewApp, tview.NewPages(), config, configFilePath, headlessApp.redrawChan)
+
+	NewModuleValidator().Validate(widgets)
+
+	headlessApp.dashboards = append(
+		headlessApp.dashboards,
+		headlessDashboard{name: name, widgets: widgets},
+	)
+
+	scheduler := NewScheduler(config)
+	for slot, widget := range expandContainers(widgets) {
+		go scheduler.Schedule(widget, slot)
+	}
+}
+
+// Handler returns the http.Handler that serves the widget data.
+//
+//	GET /api/widgets                     returns the state of all widgets
+//	GET /api/widgets/<dashboard>/<name>  returns the state of the named widget on a dashboard
+//
+// Both accept `?format=raw` to return content with its tview color tags intact
+func (headlessApp *HeadlessApp) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(headlessWidgetsPath, headlessApp.serveWidgets)
+	mux.HandleFunc(headlessWidgetsPath+"/", headlessApp.serveWidget)
+
+	return mux
+}
+
+// Serve listens on the given address and serves the widget data until it fails
+func (headlessApp *HeadlessApp) Serve(address string) error {
+	return http.ListenAndServe(address, headlessApp.Handler())
+}
+
+// Stop kills all the currently-running widgets
+func (headlessApp *HeadlessApp) Stop() {
+	for _, dashboard := range headlessApp.dashboards {
+		for _, widget := range dashboard.widgets {
+			widget.Stop()
+		}
+	}
+}
+
+// WidgetStates returns the current state of every widget, ordered by dashboard and
+// then onscreen position
+func (headlessApp *HeadlessApp) WidgetStates(raw bool) []WidgetState {
+	states := []WidgetState{}
+
+	for _, dashboard := range headlessApp.dashboards {
+		widgets := append([]wtf.Wtfable{}, dashboard.widgets...)
+		sortByPosition(widgets)
+
+		for _, widget := range expandContainers(widgets) {
+			states = append(states, widgetState(dashboard.name, widget, raw))
+		}
+	}
+
+	return states
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// serveWidget serves the state of one widget. Module names are only unique within a
+// dashboard, so the widget is looked up by its dashboard and its name
+func (headlessApp *HeadlessApp) serveWidget(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, headlessWidgetsPath+"/")
+
+	dashboard, name, found := strings.Cut(path, "/")
+	if !found {
+		http.Error(w, "widgets are addressed as "+headlessWidgetsPath+"/<dashboard>/<name>", http.StatusNotFound)
+		return
+	}
+
+	for _, state := range headlessApp.WidgetStates(isRawFormat(r)) {
+		if state.Dashboard == dashboard && state.Name == name {
+			writeJSON(w, state)
+			return
+		}
+	}
+
+	http.Error(w, "widget not found: "+path, http.StatusNotFound)
+}
+
+func (headlessApp *HeadlessApp) serveWidgets(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, headlessApp.WidgetStates(isRawFormat(r)))
+}
+
+func isRawFormat(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "raw"
+}
+
+func sortByPosition(widgets []wtf.Wtfable) {
+	sort.SliceStable(widgets, func(i, j int) bool {
+		iSettings := widgets[i].CommonSettings()
+		jSettings := widgets[j].CommonSettings()
+
+		if iSettings.Top != jSettings.Top {
+			return iSettings.Top < jSettings.Top
+		}
+		return iSettings.Left < jSettings.Left
+	})
+}
+
+func widgetState(dashboard string, widget wtf.Wtfable, raw bool) WidgetState {
+	view := widget.TextView()
+
+	view.Lock()
+	title := view.GetTitle()
+	content := view.GetText(false)
+	view.Unlock()
+
+	if !raw {
+		title = utils.StripColorTags(title)
+		content = utils.StripColorTags(content)
+	}
+
+	settings := widget.CommonSettings()
+
+	state := WidgetState{
+		Dashboard: dashboard,
+		Name:      widget.Name(),
+		Type:      settings.Type,
+		Title:     strings.TrimSpace(title),
+		Content:   content,
+	}
+
+	state.Position.Top = settings.Top
+	state.Position.Left = settings.Left
+	state.Position.Width = settings.Width
+	state.Position.Height = settings.Height
+
+	if refreshedAt := widget.RefreshedAt(); !refreshedAt.IsZero() {
+		state.RefreshedAt = &refreshedAt
+	}
+
+	// Modules displaying the data they cached before WTF started say how old it is
+	if cacheable, ok := widget.(wtf.Cacheable); ok {
+		if cachedAt := cacheable.CachedAt(); !cachedAt.IsZero() {
+			state.CachedAt = &cachedAt
+		}
+	}
+
+	return state
+}
+
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
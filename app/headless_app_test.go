@@ -0,0 +1,78 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const headlessConfig = `
+wtf:
+  mods:
+    clocks:
+      enabled: true
+      locations:
+        UTC: "Etc/UTC"
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+      refreshInterval: 30
+      title: "World Clocks"`
+
+func Test_HeadlessApp(t *testing.T) {
+	cfg, _ := config.ParseYaml(headlessConfig)
+
+	headlessApp := NewHeadlessApp()
+	headlessApp.AddDashboard("main", cfg, "")
+	defer headlessApp.Stop()
+
+	for _, dashboard := range headlessApp.dashboards {
+		for _, widget := range dashboard.widgets {
+			widget.Refresh()
+		}
+	}
+
+	t.Run("all widgets", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		headlessApp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/widgets", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		states := []WidgetState{}
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &states))
+
+		assert.Equal(t, 1, len(states))
+		assert.Equal(t, "main", states[0].Dashboard)
+		assert.Equal(t, "clocks", states[0].Name)
+		assert.Equal(t, "World Clocks", states[0].Title)
+		assert.Contains(t, states[0].Content, "UTC")
+		assert.NotNil(t, states[0].RefreshedAt)
+	})
+
+	t.Run("single widget", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		headlessApp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/widgets/main/clocks", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("widget on another dashboard", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		headlessApp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/widgets/other/clocks", nil))
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("unknown widget", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		headlessApp.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/widgets/main/nope", nil))
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
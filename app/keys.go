@@ -0,0 +1,153 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+)
+
+// The names of the app-wide keyboard actions, for rebinding them in `wtf.keys`
+const (
+	actionCommandPalette = "command-palette"
+	actionNextDashboard  = "next-dashboard"
+	actionNextWidget     = "next-widget"
+	actionPickDashboard  = "pick-dashboard"
+	actionPrevWidget     = "prev-widget"
+	actionQuit           = "quit"
+	actionRefreshAll     = "refresh-all"
+	actionShowErrors     = "show-errors"
+	actionUnfocus        = "unfocus"
+	actionZoom           = "zoom"
+)
+
+// GlobalKeyAction is an app-wide keyboard action and the keys it's bound to by default.
+// Character keys only trigger app-wide actions when no widget has focus, so widgets are
+// free to use the same characters. Special keys, such as Tab or Ctrl-R, take precedence
+// over every widget's keys
+type GlobalKeyAction struct {
+	Action string
+	Keys   []string
+	Help   string
+}
+
+// GlobalKeyActions returns the app-wide keyboard actions, in order of precedence
+func GlobalKeyActions() []GlobalKeyAction {
+	return []GlobalKeyAction{
+		{Action: actionCommandPalette, Keys: []string{":", "Ctrl-P"}, Help: "Search the widgets and their commands"},
+		{Action: actionNextDashboard, Keys: []string{"Ctrl-Space"}, Help: "Switch to the next dashboard"},
+		{Action: actionPickDashboard, Keys: []string{"Ctrl-B"}, Help: "Choose a dashboard from a list"},
+		{Action: actionShowErrors, Keys: []string{"Ctrl-E"}, Help: "Show the widgets whose last refresh failed"},
+		{Action: actionRefreshAll, Keys: []string{"Ctrl-R"}, Help: "Refresh every widget"},
+		{Action: actionZoom, Keys: []string{"Ctrl-Z"}, Help: "Show the focused widget full-screen, or put it back"},
+		{Action: actionNextWidget, Keys: []string{"Tab"}, Help: "Focus the next widget"},
+		{Action: actionPrevWidget, Keys: []string{"Backtab"}, Help: "Focus the previous widget"},
+		{Action: actionUnfocus, Keys: []string{"Esc"}, Help: "Remove focus from the focused widget"},
+		{Action: actionQuit, Keys: []string{"q"}, Help: "Quit"},
+	}
+}
+
+// globalKeys maps the keys pressed to the app-wide actions they're bound to
+type globalKeys struct {
+	actions map[string]string
+}
+
+// newGlobalKeys binds the app-wide actions to their default keys, or to the keys they've
+// been rebound to in `wtf.keys`. Rebound keys win over default ones, otherwise a key bound
+// to more than one action stays with the first
+func newGlobalKeys(config *config.Config) *globalKeys {
+	bindings := cfg.NewKeyBindingsFromYAML(nil, config)
+	keys := &globalKeys{actions: map[string]string{}}
+
+	for _, rebound := range []bool{true, false} {
+		for _, globalAction := range GlobalKeyActions() {
+			names, ok := bindings.Keys(globalAction.Action)
+			if ok != rebound {
+				continue
+			}
+
+			if !rebound {
+				names = globalAction.Keys
+			}
+
+			keys.bind(globalAction.Action, names)
+		}
+	}
+
+	return keys
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// globalActionFuncs returns the functions that carry out the app-wide actions, for
+// running them from the command palette
+func (wtfApp *WtfApp) globalActionFuncs() map[string]func() {
+	appMan := wtfApp.appManager
+
+	return map[string]func(){
+		actionNextDashboard: func() {
+			if appMan != nil {
+				appMan.Select((appMan.selected + 1) % len(appMan.WtfApps))
+			}
+		},
+		actionNextWidget: wtfApp.focusTracker.Next,
+		actionPickDashboard: func() {
+			if appMan != nil {
+				appMan.showDashboardPicker()
+			}
+		},
+		actionPrevWidget: wtfApp.focusTracker.Prev,
+		actionQuit:       wtfApp.Exit,
+		actionRefreshAll: wtfApp.refreshAllWidgets,
+		actionShowErrors: wtfApp.showErrorOverlay,
+		actionUnfocus: func() {
+			wtfApp.unzoom()
+			wtfApp.focusTracker.None()
+		},
+		actionZoom: wtfApp.toggleZoom,
+	}
+}
+
+// bind assigns the keys to the action, skipping any that are invalid or already taken
+func (keys *globalKeys) bind(action string, names []string) {
+	for _, name := range names {
+		_, _, canonical, err := cfg.ParseKey(name)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("%s cannot be bound to %s: %s", action, name, err))
+			continue
+		}
+
+		if owner, taken := keys.actions[canonical]; taken {
+			logger.Warn(fmt.Sprintf("%s is bound to both %s and %s, %s keeps it", canonical, owner, action, owner))
+			continue
+		}
+
+		keys.actions[canonical] = action
+	}
+}
+
+// keysFor returns the keys bound to an action, in alphabetical order
+func (keys *globalKeys) keysFor(action string) []string {
+	names := []string{}
+	for name, owner := range keys.actions {
+		if owner == action {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// action returns the name of the app-wide action bound to the pressed key, if any. While
+// a widget has focus, the characters it's typed go to the widget instead
+func (keys *globalKeys) action(event *tcell.EventKey, widgetFocused bool) string {
+	if widgetFocused && event.Key() == tcell.KeyRune {
+		return ""
+	}
+
+	return keys.actions[cfg.EventKeyName(event)]
+}
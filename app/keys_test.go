@@ -0,0 +1,50 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newGlobalKeys(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg, _ := config.ParseYaml("wtf:\n  mods: {}\n")
+		keys := newGlobalKeys(cfg)
+
+		assert.Equal(t, actionQuit, keys.action(tcell.NewEventKey(tcell.KeyRune, 'q', tcell.ModNone), false))
+		assert.Equal(t, actionNextWidget, keys.action(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone), false))
+		assert.Equal(t, actionNextDashboard, keys.action(tcell.NewEventKey(tcell.KeyCtrlSpace, 0, tcell.ModNone), false))
+		assert.Equal(t, "", keys.action(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone), false))
+	})
+
+	t.Run("with a focused widget", func(t *testing.T) {
+		cfg, _ := config.ParseYaml("wtf:\n  mods: {}\n")
+		keys := newGlobalKeys(cfg)
+
+		assert.Equal(t, "", keys.action(tcell.NewEventKey(tcell.KeyRune, 'q', tcell.ModNone), true))
+		assert.Equal(t, actionNextWidget, keys.action(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone), true))
+	})
+
+	t.Run("rebound", func(t *testing.T) {
+		cfg, _ := config.ParseYaml(`
+wtf:
+  keys:
+    quit: [x, Ctrl-Q]
+    next-widget: Esc
+    refresh-all: ""
+`)
+		keys := newGlobalKeys(cfg)
+
+		assert.Equal(t, actionQuit, keys.action(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone), false))
+		assert.Equal(t, actionQuit, keys.action(tcell.NewEventKey(tcell.KeyCtrlQ, 0, tcell.ModNone), false))
+		assert.Equal(t, "", keys.action(tcell.NewEventKey(tcell.KeyRune, 'q', tcell.ModNone), false))
+
+		// Rebound keys win over the defaults of other actions
+		assert.Equal(t, actionNextWidget, keys.action(tcell.NewEventKey(tcell.KeyEsc, 0, tcell.ModNone), false))
+		assert.Equal(t, "", keys.action(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone), false))
+
+		assert.Equal(t, "", keys.action(tcell.NewEventKey(tcell.KeyCtrlR, 0, tcell.ModNone), false))
+	})
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	// defaultFlowColumnWidth is how wide flow layouts try to make each column
+	defaultFlowColumnWidth = 50
+
+	// defaultLayoutName is the name of the layout described by `wtf.grid`
+	defaultLayoutName = "default"
+)
+
+// layout is an arrangement of the widgets on the screen. A grid layout places each widget
+// at its position, a flow layout packs them into as many columns as fit, in order of
+// priority. Named layouts are used while the terminal's size is within their breakpoints:
+//
+//	wtf:
+//	  layouts:
+//	    - name: laptop
+//	      maxWidth: 159
+//	      columns: [40, 40]
+//	      rows: [10, 10, 10, 10]
+//	    - name: narrow
+//	      maxWidth: 99
+//	      flow: true
+//	      columnWidth: 45
+type layout struct {
+	name string
+
+	// Grid layouts
+	columns []int
+	rows    []int
+
+	// Flow layouts
+	flow        bool
+	columnWidth int
+	maxColumns  int
+	rowHeight   int
+
+	// Breakpoints, zero means no limit
+	maxHeight int
+	maxWidth  int
+	minHeight int
+	minWidth  int
+}
+
+// placement is where in the grid a widget is displayed
+type placement struct {
+	widget wtf.Wtfable
+
+	height int
+	left   int
+	top    int
+	width  int
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newLayouts reads the named layouts in `wtf.layouts`, in the order they're tried, and
+// the default layout used when none of them match. The default is the grid in `wtf.grid`,
+// or a flow layout if there isn't one
+func newLayouts(config *config.Config) ([]layout, layout) {
+	fallback := layout{
+		name:    defaultLayoutName,
+		columns: utils.ToInts(config.UList("wtf.grid.columns")),
+		rows:    utils.ToInts(config.UList("wtf.grid.rows")),
+	}
+
+	if len(fallback.columns) == 0 || len(fallback.rows) == 0 {
+		fallback.flow = true
+		fallback.columnWidth = defaultFlowColumnWidth
+	}
+
+	layouts := []layout{}
+
+	for idx := range config.UList("wtf.layouts") {
+		layoutConfig, err := config.Get("wtf.layouts." + strconv.Itoa(idx))
+		if err != nil {
+			continue
+		}
+
+		layouts = append(layouts, layout{
+			name: layoutConfig.UString("name", strconv.Itoa(idx)),
+
+			columns: utils.ToInts(layoutConfig.UList("columns")),
+			rows:    utils.ToInts(layoutConfig.UList("rows")),
+
+			flow:        layoutConfig.UBool("flow", false),
+			columnWidth: layoutConfig.UInt("columnWidth", defaultFlowColumnWidth),
+			maxColumns:  layoutConfig.UInt("maxColumns", 0),
+			rowHeight:   layoutConfig.UInt("rowHeight", 0),
+
+			maxHeight: layoutConfig.UInt("maxHeight", 0),
+			maxWidth:  layoutConfig.UInt("maxWidth", 0),
+			minHeight: layoutConfig.UInt("minHeight", 0),
+			minWidth:  layoutConfig.UInt("minWidth", 0),
+		})
+	}
+
+	return layouts, fallback
+}
+
+// selectLayout returns the first layout whose breakpoints the screen size is within, or
+// the fallback if there isn't one
+func selectLayout(layouts []layout, fallback layout, width, height int) layout {
+	for _, layout := range layouts {
+		if layout.matches(width, height) {
+			return layout
+		}
+	}
+
+	return fallback
+}
+
+func (layout layout) matches(width, height int) bool {
+	return (layout.minWidth == 0 || width >= layout.minWidth) &&
+		(layout.maxWidth == 0 || width <= layout.maxWidth) &&
+		(layout.minHeight == 0 || height >= layout.minHeight) &&
+		(layout.maxHeight == 0 || height <= layout.maxHeight)
+}
+
+// place works out the grid's columns and rows, and where each widget goes in it, for a
+// screen of the given width
+func (layout layout) place(widgets []wtf.Wtfable, width int) ([]int, []int, []placement) {
+	if layout.flow {
+		return layout.placeFlow(widgets, width)
+	}
+
+	placements := []placement{}
+
+	for _, widget := range widgets {
+		common := widget.CommonSettings()
+
+		position := common.PositionSettings
+		if layoutPosition, ok := common.Layouts[layout.name]; ok {
+			position = layoutPosition
+		}
+
+		// Grid layouts have nowhere to put a widget without a position
+		if !position.Positioned {
+			continue
+		}
+
+		placements = append(placements, placement{
+			widget: widget,
+			height: position.Height,
+			left:   position.Left,
+			top:    position.Top,
+			width:  position.Width,
+		})
+	}
+
+	return layout.columns, layout.rows, placements
+}
+
+// placeFlow packs the widgets into as many columns of about columnWidth as fit across the
+// screen, a row at a time, highest priority first. Widgets with the same priority are
+// placed in order of name
+func (layout layout) placeFlow(widgets []wtf.Wtfable, width int) ([]int, []int, []placement) {
+	ordered := append([]wtf.Wtfable{}, widgets...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i].CommonSettings(), ordered[j].CommonSettings()
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.Name < b.Name
+	})
+
+	columnWidth := layout.columnWidth
+	if columnWidth <= 0 {
+		columnWidth = defaultFlowColumnWidth
+	}
+
+	count := utils.MaxInt(width/columnWidth, 1)
+	if layout.maxColumns > 0 && count > layout.maxColumns {
+		count = layout.maxColumns
+	}
+	if count > len(ordered) {
+		count = utils.MaxInt(len(ordered), 1)
+	}
+
+	rowCount := (len(ordered) + count - 1) / count
+
+	// Zero-sized columns and rows share out the screen equally
+	columns := make([]int, count)
+	rows := make([]int, rowCount)
+	for idx := range rows {
+		rows[idx] = layout.rowHeight
+	}
+
+	placements := make([]placement, len(ordered))
+	for idx, widget := range ordered {
+		placements[idx] = placement{
+			widget: widget,
+			height: 1,
+			left:   idx % count,
+			top:    idx / count,
+			width:  1,
+		}
+	}
+
+	return columns, rows, placements
+}
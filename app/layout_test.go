@@ -0,0 +1,111 @@
+package app
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const layoutConfig = `
+wtf:
+  grid:
+    columns: [40, 40, 40]
+    rows: [10, 10]
+  layouts:
+    - name: laptop
+      maxWidth: 159
+      columns: [40, 40]
+      rows: [10, 10, 10]
+    - name: narrow
+      maxWidth: 99
+      flow: true
+      columnWidth: 45
+  mods:
+    alpha:
+      type: clocks
+      enabled: true
+      position: {top: 0, left: 0, height: 1, width: 2}
+      layouts:
+        laptop: {top: 0, left: 0, height: 1, width: 1}
+    beta:
+      type: clocks
+      enabled: true
+      priority: 5
+      position: {top: 0, left: 2, height: 2, width: 1}
+    gamma:
+      type: clocks
+      enabled: true
+`
+
+func layoutWidgets(t *testing.T) ([]wtf.Wtfable, *config.Config) {
+	config, err := config.ParseYaml(layoutConfig)
+	assert.NoError(t, err)
+
+	widgets := MakeWidgets(nil, nil, config, "", nil)
+	sort.Slice(widgets, func(i, j int) bool { return widgets[i].Name() < widgets[j].Name() })
+
+	return widgets, config
+}
+
+func placedAt(placements []placement) map[string][4]int {
+	result := map[string][4]int{}
+	for _, placement := range placements {
+		result[placement.widget.Name()] = [4]int{placement.top, placement.left, placement.height, placement.width}
+	}
+	return result
+}
+
+func Test_selectLayout(t *testing.T) {
+	_, config := layoutWidgets(t)
+	layouts, fallback := newLayouts(config)
+
+	assert.Equal(t, "default", selectLayout(layouts, fallback, 200, 50).name)
+	assert.Equal(t, "laptop", selectLayout(layouts, fallback, 159, 50).name)
+	assert.Equal(t, "laptop", selectLayout(layouts, fallback, 100, 50).name)
+
+	// The first layout that matches wins, so narrower layouts go after wider ones
+	assert.Equal(t, "laptop", selectLayout(layouts, fallback, 80, 50).name)
+	assert.Equal(t, "narrow", selectLayout(layouts[1:], fallback, 80, 50).name)
+
+	empty, _ := config.Get("wtf.mods")
+	layouts, fallback = newLayouts(empty)
+	assert.Empty(t, layouts)
+	assert.True(t, fallback.flow)
+}
+
+func Test_layout_place(t *testing.T) {
+	widgets, config := layoutWidgets(t)
+	layouts, fallback := newLayouts(config)
+
+	columns, rows, placements := fallback.place(widgets, 200)
+	assert.Equal(t, []int{40, 40, 40}, columns)
+	assert.Equal(t, []int{10, 10}, rows)
+	assert.Equal(t, map[string][4]int{
+		"alpha": {0, 0, 1, 2},
+		"beta":  {0, 2, 2, 1},
+	}, placedAt(placements))
+
+	columns, _, placements = layouts[0].place(widgets, 120)
+	assert.Equal(t, []int{40, 40}, columns)
+	assert.Equal(t, map[string][4]int{
+		"alpha": {0, 0, 1, 1},
+		"beta":  {0, 2, 2, 1},
+	}, placedAt(placements))
+
+	// Two columns of 45 fit in 95, beta has the highest priority
+	columns, rows, placements = layouts[1].place(widgets, 95)
+	assert.Equal(t, []int{0, 0}, columns)
+	assert.Equal(t, []int{0, 0}, rows)
+	assert.Equal(t, map[string][4]int{
+		"beta":  {0, 0, 1, 1},
+		"alpha": {0, 1, 1, 1},
+		"gamma": {1, 0, 1, 1},
+	}, placedAt(placements))
+
+	columns, rows, _ = layouts[1].place(widgets, 20)
+	assert.Equal(t, []int{0}, columns)
+	assert.Equal(t, []int{0, 0, 0}, rows)
+}
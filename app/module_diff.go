@@ -0,0 +1,84 @@
+package app
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/olebedev/config"
+)
+
+// moduleChanges describes how the module definitions differ between two configurations
+type moduleChanges struct {
+	added     []string
+	changed   []string
+	removed   []string
+	unchanged []string
+}
+
+// diffModules compares the `wtf.mods` sections of two configurations, module by module
+func diffModules(oldConfig, newConfig *config.Config) moduleChanges {
+	changes := moduleChanges{}
+
+	oldMods, _ := oldConfig.Map("wtf.mods")
+	newMods, _ := newConfig.Map("wtf.mods")
+
+	for _, name := range sortedKeys(newMods) {
+		oldMod, found := oldMods[name]
+
+		switch {
+		case !found:
+			changes.added = append(changes.added, name)
+		case reflect.DeepEqual(oldMod, newMods[name]):
+			changes.unchanged = append(changes.unchanged, name)
+		default:
+			changes.changed = append(changes.changed, name)
+		}
+	}
+
+	for _, name := range sortedKeys(oldMods) {
+		if _, found := newMods[name]; !found {
+			changes.removed = append(changes.removed, name)
+		}
+	}
+
+	return changes
+}
+
+// globalsChanged returns TRUE if anything other than the module definitions differs between
+// two configurations. Global settings (colors, grid, sigils, etc.) are baked into every
+// widget when it's created, so a change to them means every widget has to be rebuilt
+func globalsChanged(oldConfig, newConfig *config.Config) bool {
+	return !reflect.DeepEqual(withoutMods(oldConfig), withoutMods(newConfig))
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func sortedKeys(items map[string]interface{}) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// withoutMods returns a shallow copy of the configuration's `wtf` section with the
+// `mods` key removed
+func withoutMods(cfg *config.Config) map[string]interface{} {
+	globals := map[string]interface{}{}
+
+	wtfSection, err := cfg.Map("wtf")
+	if err != nil {
+		return globals
+	}
+
+	for key, val := range wtfSection {
+		if key == "mods" {
+			continue
+		}
+		globals[key] = val
+	}
+
+	return globals
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	beforeReload = `
+wtf:
+  grid:
+    columns: [10, 10]
+  mods:
+    clocks:
+      enabled: true
+      refreshInterval: 30
+    feedreader:
+      enabled: true
+      feeds: [a]
+    todo:
+      enabled: true`
+
+	afterReload = `
+wtf:
+  grid:
+    columns: [10, 10]
+  mods:
+    clocks:
+      enabled: true
+      refreshInterval: 30
+    feedreader:
+      enabled: true
+      feeds: [a, b]
+    ipinfo:
+      enabled: true`
+
+	afterGridChange = `
+wtf:
+  grid:
+    columns: [20, 20]
+  mods:
+    clocks:
+      enabled: true
+      refreshInterval: 30`
+)
+
+func Test_diffModules(t *testing.T) {
+	oldConfig, _ := config.ParseYaml(beforeReload)
+	newConfig, _ := config.ParseYaml(afterReload)
+
+	actual := diffModules(oldConfig, newConfig)
+
+	assert.Equal(t, []string{"ipinfo"}, actual.added)
+	assert.Equal(t, []string{"feedreader"}, actual.changed)
+	assert.Equal(t, []string{"todo"}, actual.removed)
+	assert.Equal(t, []string{"clocks"}, actual.unchanged)
+}
+
+func Test_globalsChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   string
+		after    string
+		expected bool
+	}{
+		{
+			name:     "when only modules changed",
+			before:   beforeReload,
+			after:    afterReload,
+			expected: false,
+		},
+		{
+			name:     "when the grid changed",
+			before:   beforeReload,
+			after:    afterGridChange,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldConfig, _ := config.ParseYaml(tt.before)
+			newConfig, _ := config.ParseYaml(tt.after)
+
+			assert.Equal(t, tt.expected, globalsChanged(oldConfig, newConfig))
+		})
+	}
+}
@@ -25,14 +25,12 @@ func NewModuleValidator() *ModuleValidator {
 // Validate rolls through all the enabled widgets and looks for configuration errors.
 // If it finds any it stringifies them, writes them to the console, and kills the app gracefully
 func (val *ModuleValidator) Validate(widgets []wtf.Wtfable) {
-	validationErrors := validate(widgets)
+	messages := val.Problems(widgets)
 
-	if len(validationErrors) > 0 {
+	if len(messages) > 0 {
 		fmt.Println()
-		for _, error := range validationErrors {
-			for _, message := range error.errorMessages() {
-				fmt.Println(message)
-			}
+		for _, message := range messages {
+			fmt.Println(message)
 		}
 		fmt.Println()
 
@@ -40,6 +38,18 @@ func (val *ModuleValidator) Validate(widgets []wtf.Wtfable) {
 	}
 }
 
+// Problems rolls through the widgets and returns a message for each configuration error
+// it finds, without exiting
+func (val *ModuleValidator) Problems(widgets []wtf.Wtfable) []string {
+	messages := []string{}
+
+	for _, error := range validate(widgets) {
+		messages = append(messages, error.errorMessages()...)
+	}
+
+	return messages
+}
+
 func validate(widgets []wtf.Wtfable) (widgetErrors []widgetError) {
 	for _, widget := range widgets {
 		err := widgetError{name: widget.Name()}
@@ -76,7 +76,7 @@ func Test_validate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			widget := MakeWidget(nil, nil, tt.moduleName, tt.config, make(chan bool))
+			widget := MakeWidget(nil, nil, tt.moduleName, tt.config, "", make(chan bool))
 
 			if widget == nil {
 				t.Logf("Failed to create widget %s", tt.moduleName)
@@ -100,3 +100,14 @@ func Test_validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_Problems(t *testing.T) {
+	validConfig, _ := config.ParseYaml(valid)
+	invalidConfig, _ := config.ParseYaml(invalid)
+
+	validWidget := MakeWidget(nil, nil, "clocks", validConfig, "", make(chan bool))
+	invalidWidget := MakeWidget(nil, nil, "clocks", invalidConfig, "", make(chan bool))
+
+	assert.Empty(t, NewModuleValidator().Problems([]wtf.Wtfable{validWidget}))
+	assert.Len(t, NewModuleValidator().Problems([]wtf.Wtfable{validWidget, invalidWidget}), 2)
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// mouseIntercept moves the focus to the widget that's clicked on through the focus
+// tracker, so that it's focused the same way it would be from the keyboard. Clicks on
+// widgets that can't be focused are dropped, otherwise tview would focus their views.
+// Everything else, such as scrolling, is left to the views themselves
+func (wtfApp *WtfApp) mouseIntercept(event *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+	if action != tview.MouseLeftClick {
+		return event, action
+	}
+
+	// Modals, the help and the command palette handle their own clicks
+	if front := frontPage(wtfApp.pages); front != gridPage && front != zoomPage {
+		return event, action
+	}
+
+	widget := wtfApp.widgetAt(event.Position())
+	if widget == nil {
+		return event, action
+	}
+
+	if !widget.Focusable() {
+		return nil, action
+	}
+
+	if focused := wtfApp.focusTracker.focused(); focused == nil || focused.Name() != widget.Name() {
+		wtfApp.focusTracker.FocusOnName(widget.Name())
+	}
+
+	return event, action
+}
+
+// widgetAt returns the widget displayed at the given screen position, or nil if there's
+// none there
+func (wtfApp *WtfApp) widgetAt(x, y int) wtf.Wtfable {
+	if wtfApp.zoomed != nil {
+		if wtfApp.zoomed.widget.TextView().InRect(x, y) {
+			return wtfApp.zoomed.widget
+		}
+
+		return nil
+	}
+
+	return wtfApp.display.widgetAt(x, y)
+}
+
+// frontPage returns the name of the page that's displayed on top
+func frontPage(pages *tview.Pages) string {
+	name, _ := pages.GetFrontPage()
+	return name
+}
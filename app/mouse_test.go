@@ -0,0 +1,64 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+const mouseConfig = `
+wtf:
+  grid:
+    columns: [40, 40]
+    rows: [10]
+  mods:
+    focused:
+      type: clocks
+      enabled: true
+      focusable: true
+      position: {top: 0, left: 0, height: 1, width: 1}
+    unfocusable:
+      type: clocks
+      enabled: true
+      focusable: false
+      position: {top: 0, left: 1, height: 1, width: 1}`
+
+func Test_mouseIntercept(t *testing.T) {
+	cfg, _ := config.ParseYaml(mouseConfig)
+
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+	defer wtfApp.Stop()
+
+	screen := tcell.NewSimulationScreen("")
+	assert.NoError(t, screen.Init())
+	screen.SetSize(80, 10)
+
+	wtfApp.pages.SetRect(0, 0, 80, 10)
+	wtfApp.pages.Draw(screen)
+
+	click := func(x, y int) *tcell.EventMouse {
+		event, _ := wtfApp.mouseIntercept(tcell.NewEventMouse(x, y, tcell.Button1, tcell.ModNone), tview.MouseLeftClick)
+		return event
+	}
+
+	t.Run("on a widget that can't be focused", func(t *testing.T) {
+		assert.Nil(t, click(60, 5))
+		assert.Nil(t, wtfApp.focusTracker.focused())
+	})
+
+	t.Run("on a focusable widget", func(t *testing.T) {
+		assert.NotNil(t, click(20, 5))
+		assert.True(t, wtfApp.focusTracker.IsFocused)
+		assert.Equal(t, "focused", wtfApp.focusTracker.focused().Name())
+	})
+
+	t.Run("with another page in front", func(t *testing.T) {
+		wtfApp.pages.AddPage("modal", tview.NewBox(), false, true)
+		defer wtfApp.pages.RemovePage("modal")
+
+		assert.NotNil(t, click(60, 5))
+	})
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// reloadConfig re-reads the config file and brings the onscreen widgets in line with it.
+//
+// Only the widgets whose module configuration has changed are rebuilt. Widgets for modules
+// that were removed are stopped, widgets for new modules are created, and everything else
+// keeps running with its current data, scroll position and selection. If any of the global
+// settings have changed, every widget is rebuilt. If the config can't be loaded, or the new
+// module configuration has errors, the current display is kept.
+func (wtfApp *WtfApp) reloadConfig() {
+	newConfig, err := cfg.ReadWtfConfigFile(wtfApp.configFilePath)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Reloading %s failed, keeping the current display: %s", wtfApp.configFilePath, err.Error()))
+		return
+	}
+
+	// The logger, alert rules, HTTP client, UI state and the utilities that open files and
+	// URLs are shared by every dashboard, so only the main config file sets them up
+	mainConfig := wtfApp.isMainConfig()
+
+	if mainConfig {
+		cfg.ConfigureLogger(newConfig)
+	}
+
+	// The secrets files may have changed along with the config
+	cfg.ForgetSecretFiles()
+	cfg.InterpolateConfig(newConfig)
+
+	if mainConfig {
+		cfg.ConfigureAlerts(newConfig)
+		cfg.ConfigureHTTP(newConfig)
+		cfg.ConfigureState(newConfig)
+
+		openURLUtil := utils.ToStrs(newConfig.UList("wtf.openUrlUtil", []interface{}{}))
+		utils.Init(newConfig.UString("wtf.openFileUtil", "open"), openURLUtil)
+	}
+
+	// The app's widgets, scheduler and config are only ever touched on the UI goroutine
+	wtfApp.TViewApp.QueueUpdateDraw(func() {
+		wtfApp.applyConfig(newConfig)
+	})
+}
+
+// applyConfig rebuilds the widgets whose configuration differs in the new config, swaps
+// them in and schedules them. It must be called on the UI goroutine
+func (wtfApp *WtfApp) applyConfig(newConfig *config.Config) {
+	changes := diffModules(wtfApp.config, newConfig)
+	if globalsChanged(wtfApp.config, newConfig) {
+		changes.changed = append(changes.changed, changes.unchanged...)
+		changes.unchanged = nil
+	}
+
+	existing := map[string]wtf.Wtfable{}
+	for _, widget := range wtfApp.widgets {
+		existing[widget.Name()] = widget
+	}
+
+	widgets := []wtf.Wtfable{}
+	created := []wtf.Wtfable{}
+
+	for _, name := range changes.unchanged {
+		if widget, ok := existing[name]; ok {
+			widgets = append(widgets, widget)
+		}
+	}
+
+	for _, name := range append(changes.changed, changes.added...) {
+		widget := MakeWidget(wtfApp.TViewApp, wtfApp.pages, name, newConfig, wtfApp.configFilePath, wtfApp.redrawChan)
+		if widget == nil {
+			continue
+		}
+
+		widgets = append(widgets, widget)
+		created = append(created, widget)
+	}
+
+	if problems := wtfApp.validator.Problems(created); len(problems) > 0 {
+		for _, problem := range problems {
+			logger.Error(problem)
+		}
+		logger.Warn("The reloaded configuration has errors, keeping the current display")
+
+		stopWidgets(created)
+		return
+	}
+
+	if len(widgets) == 0 {
+		logger.Warn("No modules are defined after reloading the configuration, keeping the current display")
+		return
+	}
+
+	for _, name := range append(changes.changed, changes.removed...) {
+		if widget, ok := existing[name]; ok {
+			widget.Stop()
+		}
+	}
+
+	logger.Info(
+		fmt.Sprintf(
+			"Reloaded %s: %d added, %d changed, %d removed, %d unchanged",
+			wtfApp.configFilePath,
+			len(changes.added), len(changes.changed), len(changes.removed), len(changes.unchanged),
+		),
+	)
+
+	wtfApp.swapWidgets(newConfig, widgets)
+
+	for _, widget := range created {
+		if wtfApp.paused {
+			widget.Pause()
+		}
+	}
+
+	for slot, widget := range expandContainers(created) {
+		go wtfApp.scheduler.Schedule(widget, slot)
+	}
+}
+
+// swapWidgets replaces the widgets displayed by the app, keeping focus on the
+// previously-focused widget if it's still around
+func (wtfApp *WtfApp) swapWidgets(newConfig *config.Config, widgets []wtf.Wtfable) {
+	// The zoomed widget might be one of the ones being replaced
+	wtfApp.unzoom()
+
+	focusedName := ""
+	if focused := wtfApp.focusTracker.focusableAt(wtfApp.focusTracker.Idx); focused != nil && wtfApp.focusTracker.IsFocused {
+		focusedName = focused.Name()
+	}
+
+	wtfApp.config = newConfig
+	wtfApp.keys = newGlobalKeys(newConfig)
+	wtfApp.scheduler = NewScheduler(newConfig)
+	wtfApp.widgets = widgets
+
+	wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
+	wtfApp.focusTracker = NewFocusTracker(wtfApp.TViewApp, wtfApp.widgets, wtfApp.config, wtfApp.configFilePath)
+
+	wtfApp.pages.AddPage(gridPage, wtfApp.display.Grid, true, true)
+	wtfApp.pages.SendToBack(gridPage)
+
+	if focusedName != "" {
+		wtfApp.focusTracker.FocusOnName(focusedName)
+		return
+	}
+
+	if !wtfApp.paused {
+		wtfApp.TViewApp.SetFocus(wtfApp.pages)
+	}
+}
+
+// stopWidgets stops the widgets, for when they're discarded without being displayed
+func stopWidgets(widgets []wtf.Wtfable) {
+	for _, widget := range widgets {
+		widget.Stop()
+	}
+}
@@ -1,38 +1,157 @@
 package app
 
 import (
+	"math"
+	"math/rand"
+	"sync"
 	"time"
 
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/wtf"
 )
 
-// Schedule kicks off the first refresh of a module's data and then queues the rest of the
-// data refreshes on a timer
-func Schedule(widget wtf.Wtfable) {
-	widget.Refresh()
+const (
+	defaultJitter     = 0.1
+	defaultMaxBackoff = "15m"
+	defaultStagger    = "200ms"
+)
+
+// Scheduler refreshes widgets on their configured refresh intervals.
+//
+// Initial refreshes are staggered so that every widget doesn't hit the network at once,
+// each interval is randomly jittered so that widgets drift apart over time, and widgets
+// that report refresh failures are backed off exponentially. It is configured under
+// `wtf.scheduler`:
+//
+//	wtf:
+//	  scheduler:
+//	    jitter: 0.1         # +/- fraction of each interval to randomly add
+//	    maxBackoff: "15m"   # the longest a failing widget will wait between refreshes
+//	    stagger: "200ms"    # delay between the initial refreshes of successive widgets
+type Scheduler struct {
+	jitter     float64
+	maxBackoff time.Duration
+	stagger    time.Duration
+
+	random      *rand.Rand
+	randomMutex *sync.Mutex
+}
+
+// NewScheduler creates and returns an instance of Scheduler configured from the
+// global configuration
+func NewScheduler(config *config.Config) *Scheduler {
+	jitter := config.UFloat64("wtf.scheduler.jitter", defaultJitter)
+	jitter = math.Max(0, math.Min(jitter, 1))
+
+	scheduler := &Scheduler{
+		jitter:     jitter,
+		maxBackoff: cfg.ParseTimeString(config, "wtf.scheduler.maxBackoff", defaultMaxBackoff),
+		stagger:    cfg.ParseTimeString(config, "wtf.scheduler.stagger", defaultStagger),
+
+		random:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		randomMutex: &sync.Mutex{},
+	}
+
+	return scheduler
+}
 
+/* -------------------- Exported Functions -------------------- */
+
+// Schedule kicks off the first refresh of a module's data and then queues the rest of the
+// data refreshes on a timer. The slot is the widget's place in the startup order and
+// determines how long its first refresh is delayed. Modules that cache their data display
+// it in the meantime. Refreshes are skipped while the module is paused.
+func (scheduler *Scheduler) Schedule(widget wtf.Wtfable, slot int) {
 	interval := widget.CommonSettings().RefreshInterval
 
-	if interval <= 0 {
-		return
+	if cacheable, ok := widget.(wtf.Cacheable); ok && widget.Enabled() {
+		cacheable.RenderCached()
 	}
 
-	timer := time.NewTicker(interval)
+	timer := time.NewTimer(scheduler.startDelay(slot))
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-timer.C:
-			if widget.Enabled() {
+			if !widget.Enabled() {
+				return
+			}
+
+			if !widget.Paused() {
 				widget.Refresh()
-			} else {
-				timer.Stop()
+			}
+
+			if interval <= 0 {
 				return
 			}
+
+			timer.Reset(scheduler.nextDelay(interval, widget.ConsecutiveFailures()))
 		case quit := <-widget.QuitChan():
 			if quit {
-				timer.Stop()
 				return
 			}
 		}
 	}
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// backoff returns the refresh interval for a widget that has failed the given number of
+// times in a row, doubling the interval for each failure up to maxBackoff
+func (scheduler *Scheduler) backoff(interval time.Duration, failures int) time.Duration {
+	if failures <= 0 {
+		return interval
+	}
+
+	limit := scheduler.maxBackoff
+	if limit < interval {
+		limit = interval
+	}
+
+	delay := interval
+	for i := 0; i < failures && delay < limit; i++ {
+		delay *= 2
+	}
+
+	if delay > limit {
+		delay = limit
+	}
+
+	return delay
+}
+
+// nextDelay returns how long to wait before a widget's next refresh
+func (scheduler *Scheduler) nextDelay(interval time.Duration, failures int) time.Duration {
+	delay := scheduler.backoff(interval, failures)
+
+	if scheduler.jitter == 0 {
+		return delay
+	}
+
+	spread := float64(delay) * scheduler.jitter
+	offset := (scheduler.randomFloat()*2 - 1) * spread
+
+	return delay + time.Duration(offset)
+}
+
+func (scheduler *Scheduler) randomFloat() float64 {
+	scheduler.randomMutex.Lock()
+	defer scheduler.randomMutex.Unlock()
+
+	return scheduler.random.Float64()
+}
+
+// startDelay returns how long the widget in the given startup slot should wait before
+// its first refresh. The first widget refreshes immediately
+func (scheduler *Scheduler) startDelay(slot int) time.Duration {
+	if slot <= 0 || scheduler.stagger <= 0 {
+		return 0
+	}
+
+	delay := time.Duration(slot) * scheduler.stagger
+	jitter := time.Duration(scheduler.randomFloat() * float64(scheduler.stagger))
+
+	return delay + jitter
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -66,7 +67,7 @@ func Test_RefreshInterval(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			widget := MakeWidget(nil, nil, tt.moduleName, tt.config, make(chan bool))
+			widget := MakeWidget(nil, nil, tt.moduleName, tt.config, "", make(chan bool))
 
 			interval := widget.CommonSettings().RefreshInterval // same declaration as in scheduler.go#Schedule
 			timer := time.NewTicker(interval)
@@ -88,3 +89,77 @@ func Test_RefreshInterval(t *testing.T) {
 		})
 	}
 }
+
+func Test_Scheduler_backoff(t *testing.T) {
+	scheduler := &Scheduler{maxBackoff: 10 * time.Minute}
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		failures int
+		expected time.Duration
+	}{
+		{
+			name:     "with no failures",
+			interval: time.Minute,
+			failures: 0,
+			expected: time.Minute,
+		},
+		{
+			name:     "with one failure",
+			interval: time.Minute,
+			failures: 1,
+			expected: 2 * time.Minute,
+		},
+		{
+			name:     "with three failures",
+			interval: time.Minute,
+			failures: 3,
+			expected: 8 * time.Minute,
+		},
+		{
+			name:     "with many failures",
+			interval: time.Minute,
+			failures: 50,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:     "with an interval longer than the maximum backoff",
+			interval: time.Hour,
+			failures: 2,
+			expected: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, scheduler.backoff(tt.interval, tt.failures))
+		})
+	}
+}
+
+func Test_Scheduler_nextDelay(t *testing.T) {
+	cfg, _ := config.ParseYaml("wtf:\n  scheduler:\n    jitter: 0.2\n    maxBackoff: 1h")
+	scheduler := NewScheduler(cfg)
+
+	for i := 0; i < 100; i++ {
+		delay := scheduler.nextDelay(10*time.Second, 0)
+
+		assert.GreaterOrEqual(t, delay, 8*time.Second)
+		assert.LessOrEqual(t, delay, 12*time.Second)
+	}
+}
+
+func Test_Scheduler_startDelay(t *testing.T) {
+	cfg, _ := config.ParseYaml("wtf:\n  scheduler:\n    stagger: 100ms")
+	scheduler := NewScheduler(cfg)
+
+	assert.Equal(t, time.Duration(0), scheduler.startDelay(0))
+
+	for slot := 1; slot < 10; slot++ {
+		delay := scheduler.startDelay(slot)
+
+		assert.GreaterOrEqual(t, delay, time.Duration(slot)*100*time.Millisecond)
+		assert.Less(t, delay, time.Duration(slot+1)*100*time.Millisecond)
+	}
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// DefaultSnapshotTimeout is how long a snapshot waits for the widgets to refresh
+const DefaultSnapshotTimeout = time.Minute
+
+// RenderSnapshot builds the widgets defined by the configuration loaded from
+// configFilePath, refreshes each of them once and draws them onto an off-screen terminal
+// of the given size, which is returned. Widgets that haven't finished refreshing once the
+// timeout runs out are left out, and logged
+func RenderSnapshot(config *config.Config, configFilePath string, width, height int, timeout time.Duration) (tcell.SimulationScreen, error) {
+	tviewApp := tview.NewApplication()
+
+	// The application's event loop never runs, so the widgets' updates can't wait for it
+	view.RunUpdatesDirectly(tviewApp)
+
+	// Nothing is drawn until the widgets have refreshed, but they still signal that they
+	// need a redraw
+	redrawChan := make(chan bool, 1)
+	go func() {
+		for range redrawChan {
+		}
+	}()
+
+	widgets := MakeWidgets(tviewApp, tview.NewPages(), config, configFilePath, redrawChan)
+	if len(widgets) == 0 {
+		return nil, errors.New("no modules were defined, make sure you have at least one properly defined widget")
+	}
+
+	defer func() {
+		for _, widget := range widgets {
+			widget.Stop()
+		}
+	}()
+
+	NewModuleValidator().Validate(widgets)
+
+	pending := refreshOnce(expandContainers(widgets), timeout)
+
+	// Widgets that are still refreshing would change while they're drawn, so they're left out
+	display := NewDisplay(settledWidgets(widgets, pending), config)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+
+	screen.SetSize(width, height)
+	screen.Clear()
+
+	display.Grid.SetRect(0, 0, width, height)
+	display.Grid.Draw(screen)
+
+	screen.Show()
+
+	return screen, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// refreshOnce refreshes each of the enabled widgets at the same time, and waits for them
+// to finish or the timeout to run out. It returns the widgets that are still refreshing
+func refreshOnce(widgets []wtf.Wtfable, timeout time.Duration) map[wtf.Wtfable]bool {
+	pending := map[wtf.Wtfable]bool{}
+	pendingMutex := &sync.Mutex{}
+
+	wg := &sync.WaitGroup{}
+
+	for _, widget := range widgets {
+		if !widget.Enabled() {
+			continue
+		}
+
+		pending[widget] = true
+		wg.Add(1)
+
+		go func(widget wtf.Wtfable) {
+			defer wg.Done()
+
+			widget.Refresh()
+
+			pendingMutex.Lock()
+			delete(pending, widget)
+			pendingMutex.Unlock()
+		}(widget)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return map[wtf.Wtfable]bool{}
+	case <-timer.C:
+	}
+
+	pendingMutex.Lock()
+	defer pendingMutex.Unlock()
+
+	stillPending := map[wtf.Wtfable]bool{}
+	for widget := range pending {
+		logger.Module(widget.Name()).Warn("Not refreshed before the snapshot was taken, leaving it out")
+		stillPending[widget] = true
+	}
+
+	return stillPending
+}
+
+// settledWidgets returns the widgets that aren't refreshing any more. A container is only
+// settled once all the widgets it contains are
+func settledWidgets(widgets []wtf.Wtfable, pending map[wtf.Wtfable]bool) []wtf.Wtfable {
+	settled := []wtf.Wtfable{}
+
+	for _, widget := range widgets {
+		refreshing := false
+		for _, expanded := range expandContainers([]wtf.Wtfable{widget}) {
+			if pending[expanded] {
+				refreshing = true
+				break
+			}
+		}
+
+		if !refreshing {
+			settled = append(settled, widget)
+		}
+	}
+
+	return settled
+}
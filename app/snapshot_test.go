@@ -0,0 +1,78 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	_ "github.com/wtfutil/wtf/modules/group"
+	"github.com/wtfutil/wtf/snapshot"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+func Test_RenderSnapshot(t *testing.T) {
+	cfg, _ := config.ParseYaml(headlessConfig)
+
+	screen, err := RenderSnapshot(cfg, "", 40, 6, time.Second)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer screen.Fini()
+
+	width, height := screen.Size()
+	assert.Equal(t, 40, width)
+	assert.Equal(t, 6, height)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, snapshot.Write(buf, screen, snapshot.Text))
+
+	lines := strings.Split(buf.String(), "\n")
+	assert.Contains(t, lines[0], "World Clocks")
+	assert.Contains(t, lines[1], "UTC")
+}
+
+func Test_RenderSnapshot_NoModules(t *testing.T) {
+	cfg, _ := config.ParseYaml("wtf:\n  mods: {}")
+
+	_, err := RenderSnapshot(cfg, "", 40, 6, time.Second)
+	assert.Error(t, err)
+}
+
+func Test_settledWidgets(t *testing.T) {
+	cfg, _ := config.ParseYaml(`
+wtf:
+  mods:
+    times:
+      type: group
+      enabled: true
+      mods:
+        utc:
+          type: clocks
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+    clocks:
+      enabled: true
+      position:
+        top: 0
+        left: 1
+        height: 1
+        width: 1`)
+
+	widgets := MakeWidgets(nil, nil, cfg, "", nil)
+	assert.Equal(t, 2, len(widgets))
+
+	assert.Equal(t, widgets, settledWidgets(widgets, map[wtf.Wtfable]bool{}))
+
+	// A group is left out while any of the widgets in it are refreshing
+	children := expandContainers(widgets)
+	for _, child := range children {
+		pending := map[wtf.Wtfable]bool{child: true}
+		assert.Equal(t, 1, len(settledWidgets(widgets, pending)))
+	}
+}
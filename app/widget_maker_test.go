@@ -70,8 +70,17 @@ func Test_MakeWidget(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := MakeWidget(nil, nil, tt.moduleName, tt.config, make(chan bool))
+			actual := MakeWidget(nil, nil, tt.moduleName, tt.config, "", make(chan bool))
 			assert.IsType(t, tt.expected, actual)
 		})
 	}
 }
+
+func Test_MakeSettings(t *testing.T) {
+	cfg, _ := config.ParseYaml(disabled)
+
+	settings := MakeSettings("clocks", cfg)
+	assert.IsType(t, &clocks.Settings{}, settings)
+
+	assert.Nil(t, MakeSettings("nonexistent", cfg))
+}
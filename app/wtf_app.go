@@ -12,8 +12,8 @@ import (
 	"github.com/radovskyb/watcher"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/support"
-	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/wtf"
 )
 
@@ -22,14 +22,20 @@ import (
 type WtfApp struct {
 	TViewApp *tview.Application
 
+	appManager     *WtfAppManager
 	config         *config.Config
 	configFilePath string
 	display        *Display
 	focusTracker   FocusTracker
 	ghUser         *support.GitHubUser
+	keys           *globalKeys
+	name           string
 	pages          *tview.Pages
+	paused         bool
+	scheduler      *Scheduler
 	validator      *ModuleValidator
 	widgets        []wtf.Wtfable
+	zoomed         *zoomState
 
 	// The redrawChan channel is used to allow modules to signal back to the main loop that
 	// the screen needs to be explicitly redrawn, instead of waiting for tcell to redraw
@@ -54,20 +60,23 @@ func NewWtfApp(tviewApp *tview.Application, config *config.Config, configFilePat
 		return false
 	})
 
-	wtfApp.widgets = MakeWidgets(wtfApp.TViewApp, wtfApp.pages, wtfApp.config, wtfApp.redrawChan)
+	wtfApp.widgets = MakeWidgets(wtfApp.TViewApp, wtfApp.pages, wtfApp.config, wtfApp.configFilePath, wtfApp.redrawChan)
 	if len(wtfApp.widgets) == 0 {
 		fmt.Println("No modules were defined. Make sure you have at least one properly defined widget")
 		os.Exit(1)
 	}
 
 	wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
-	wtfApp.focusTracker = NewFocusTracker(wtfApp.TViewApp, wtfApp.widgets, wtfApp.config)
+	wtfApp.focusTracker = NewFocusTracker(wtfApp.TViewApp, wtfApp.widgets, wtfApp.config, wtfApp.configFilePath)
+	wtfApp.focusTracker.restoreFocus()
+	wtfApp.keys = newGlobalKeys(wtfApp.config)
+	wtfApp.scheduler = NewScheduler(wtfApp.config)
 	wtfApp.validator = NewModuleValidator()
 
 	githubAPIKey := readGitHubAPIKey(wtfApp.config)
 	wtfApp.ghUser = support.NewGitHubUser(githubAPIKey)
 
-	wtfApp.pages.AddPage("grid", wtfApp.display.Grid, true, true)
+	wtfApp.pages.AddPage(gridPage, wtfApp.display.Grid, true, true)
 
 	wtfApp.validator.Validate(wtfApp.widgets)
 
@@ -78,9 +87,6 @@ func NewWtfApp(tviewApp *tview.Application, config *config.Config, configFilePat
 		),
 	)
 
-	wtfApp.TViewApp.SetInputCapture(wtfApp.keyboardIntercept)
-	wtfApp.TViewApp.SetRoot(wtfApp.pages, true)
-
 	// Create a watcher to handle calls to redraw the screen
 	go handleRedraws(wtfApp.TViewApp, wtfApp.redrawChan)
 
@@ -92,9 +98,7 @@ func handleRedraws(tviewApp *tview.Application, redrawChan chan bool) {
 		return
 	}
 
-	for {
-		data := <-redrawChan
-
+	for data := range redrawChan {
 		if data {
 			tviewApp.Draw()
 		}
@@ -103,21 +107,39 @@ func handleRedraws(tviewApp *tview.Application, redrawChan chan bool) {
 
 /* -------------------- Exported Functions -------------------- */
 
-// Exit quits the app
+// Exit quits the app, along with any other apps managed alongside it
 func (wtfApp *WtfApp) Exit() {
-	wtfApp.Stop()
+	wtfApp.appManager.Stop()
 	wtfApp.TViewApp.Stop()
 	wtfApp.DisplayExitMessage()
 	os.Exit(0)
 }
 
-// Execute starts the underlying tview app
-func (wtfApp *WtfApp) Execute() error {
-	if err := wtfApp.TViewApp.Run(); err != nil {
-		return err
+// Name returns the name of the dashboard this app displays
+func (wtfApp *WtfApp) Name() string {
+	return wtfApp.name
+}
+
+// Pause suspends the scheduled refreshes of all the widgets in this app, typically
+// because the app is no longer onscreen
+func (wtfApp *WtfApp) Pause() {
+	wtfApp.paused = true
+
+	for _, widget := range wtfApp.widgets {
+		widget.Pause()
 	}
+}
 
-	return nil
+// Resume restarts the scheduled refreshes of all the widgets in this app and
+// immediately refreshes them so that they don't display stale data
+func (wtfApp *WtfApp) Resume() {
+	wtfApp.paused = false
+
+	for _, widget := range wtfApp.widgets {
+		widget.Resume()
+	}
+
+	wtfApp.refreshAllWidgets()
 }
 
 // Start initializes the app
@@ -143,27 +165,43 @@ func (wtfApp *WtfApp) stopAllWidgets() {
 	}
 }
 
+// isMainConfig returns TRUE if the app displays the main config file, whose global settings
+// apply to every dashboard, FALSE if it displays one of the other dashboards
+func (wtfApp *WtfApp) isMainConfig() bool {
+	return wtfApp.appManager == nil || wtfApp.appManager.configFilePath == wtfApp.configFilePath
+}
+
 func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
-	// These keys are global keys used by the app. Widgets should not implement these keys
-	switch event.Key() {
-	case tcell.KeyCtrlC:
-		wtfApp.Stop()
+	// Ctrl-C always quits, whatever the other keys are bound to
+	if event.Key() == tcell.KeyCtrlC {
+		wtfApp.appManager.Stop()
 		wtfApp.TViewApp.Stop()
 		wtfApp.DisplayExitMessage()
-	case tcell.KeyCtrlR:
+	}
+
+	// These keys are global keys used by the app. Widgets should not implement these keys
+	switch wtfApp.keys.action(event, wtfApp.focusTracker.IsFocused) {
+	case actionCommandPalette:
+		wtfApp.showCommandPalette()
+		return nil
+	case actionShowErrors:
+		wtfApp.showErrorOverlay()
+		return nil
+	case actionRefreshAll:
 		wtfApp.refreshAllWidgets()
 		return nil
-	case tcell.KeyCtrlSpace:
-		// FIXME: This can't reside in the app, the app doesn't know about
-		// the AppManager. The AppManager needs to catch this one
-		fmt.Println("Next app")
+	case actionZoom:
+		wtfApp.toggleZoom()
 		return nil
-	case tcell.KeyTab:
+	case actionNextWidget:
 		wtfApp.focusTracker.Next()
-	case tcell.KeyBacktab:
+		wtfApp.zoomOnFocused()
+	case actionPrevWidget:
 		wtfApp.focusTracker.Prev()
+		wtfApp.zoomOnFocused()
 		return nil
-	case tcell.KeyEsc:
+	case actionUnfocus:
+		wtfApp.unzoom()
 		wtfApp.focusTracker.None()
 	}
 
@@ -174,12 +212,13 @@ func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
 
 	// If no specific widget has focus, then allow the key presses to fall through to the app
 	if !wtfApp.focusTracker.IsFocused {
-		switch string(event.Rune()) {
-		case "q":
+		if wtfApp.keys.action(event, false) == actionQuit {
 			wtfApp.Exit()
-		case "/":
+		}
+
+		// The help key does nothing without a focused widget to show the help for
+		if string(event.Rune()) == "/" {
 			return nil
-		default:
 		}
 	}
 
@@ -193,13 +232,14 @@ func (wtfApp *WtfApp) refreshAllWidgets() {
 }
 
 func (wtfApp *WtfApp) scheduleWidgets() {
-	for _, widget := range wtfApp.widgets {
-		go Schedule(widget)
+	for slot, widget := range expandContainers(wtfApp.widgets) {
+		go wtfApp.scheduler.Schedule(widget, slot)
 	}
 }
 
 func (wtfApp *WtfApp) watchForConfigChanges() {
 	watch := watcher.New()
+	watched := map[string]bool{}
 
 	// Notify write events
 	watch.FilterOps(watcher.Write)
@@ -208,14 +248,12 @@ func (wtfApp *WtfApp) watchForConfigChanges() {
 		for {
 			select {
 			case <-watch.Event:
-				wtfApp.Stop()
+				wtfApp.reloadConfig()
 
-				config := cfg.LoadWtfConfigFile(wtfApp.configFilePath)
-				newApp := NewWtfApp(wtfApp.TViewApp, config, wtfApp.configFilePath)
-				openURLUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
-				utils.Init(config.UString("wtf.openFileUtil", "open"), openURLUtil)
-
-				newApp.Start()
+				// The reloaded config can include different files
+				if err := watchConfigFiles(watch, watched, wtfApp.configFilePath); err != nil {
+					logger.Warn(fmt.Sprintf("Watching the config files for changes failed: %s", err.Error()))
+				}
 			case err := <-watch.Error:
 				if err == watcher.ErrWatchedFileDeleted {
 					// Usually happens because the watcher looks for the file as the OS is updating it
@@ -228,9 +266,8 @@ func (wtfApp *WtfApp) watchForConfigChanges() {
 		}
 	}()
 
-	// Watch config file for changes.
-	absPath, _ := utils.ExpandHomeDir(wtfApp.configFilePath)
-	if err := watch.Add(absPath); err != nil {
+	// Watch the config file, and the files it includes, for changes.
+	if err := watchConfigFiles(watch, watched, wtfApp.configFilePath); err != nil {
 		log.Fatalln(err)
 	}
 
@@ -239,3 +276,37 @@ func (wtfApp *WtfApp) watchForConfigChanges() {
 		log.Fatalln(err)
 	}
 }
+
+// watchConfigFiles brings the files the watcher watches in line with the files the config
+// is currently loaded from, adding the new ones and removing those it no longer includes.
+// watched holds the paths being watched
+func watchConfigFiles(watch *watcher.Watcher, watched map[string]bool, configFilePath string) error {
+	paths := map[string]bool{}
+	for _, path := range cfg.WtfConfigFilePaths(configFilePath) {
+		paths[path] = true
+	}
+
+	for path := range watched {
+		if paths[path] {
+			continue
+		}
+
+		if err := watch.Remove(path); err != nil {
+			return err
+		}
+		delete(watched, path)
+	}
+
+	for path := range paths {
+		if watched[path] {
+			continue
+		}
+
+		if err := watch.Add(path); err != nil {
+			return err
+		}
+		watched[path] = true
+	}
+
+	return nil
+}
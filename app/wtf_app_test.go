@@ -0,0 +1,58 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radovskyb/watcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_watchConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yml")
+	basePath := filepath.Join(dir, "base.yml")
+	modsPath := filepath.Join(dir, "mods.yml")
+
+	writeFile := func(path, data string) {
+		assert.NoError(t, os.WriteFile(path, []byte(data), 0600))
+	}
+
+	writeFile(basePath, "wtf: {}\n")
+	writeFile(modsPath, "wtf: {}\n")
+	writeFile(configPath, "include: base.yml\nwtf: {}\n")
+
+	watch := watcher.New()
+	watched := map[string]bool{}
+
+	assert.NoError(t, watchConfigFiles(watch, watched, configPath))
+	assert.Equal(t, map[string]bool{configPath: true, basePath: true}, watched)
+
+	// Switching includes watches the new file and stops watching the old one
+	writeFile(configPath, "include: mods.yml\nwtf: {}\n")
+
+	assert.NoError(t, watchConfigFiles(watch, watched, configPath))
+	assert.Equal(t, map[string]bool{configPath: true, modsPath: true}, watched)
+
+	watchedFiles := watch.WatchedFiles()
+	assert.Contains(t, watchedFiles, modsPath)
+	assert.NotContains(t, watchedFiles, basePath)
+}
+
+func Test_isMainConfig(t *testing.T) {
+	appMan := &WtfAppManager{configFilePath: "/home/wtf/config.yml"}
+
+	main := &WtfApp{configFilePath: "/home/wtf/config.yml"}
+	oncall := &WtfApp{configFilePath: "/home/wtf/oncall.yml"}
+
+	// An app on its own is its own main config
+	assert.True(t, main.isMainConfig())
+	assert.True(t, oncall.isMainConfig())
+
+	appMan.Add(main)
+	appMan.Add(oncall)
+
+	assert.True(t, main.isMainConfig())
+	assert.False(t, oncall.isMainConfig())
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const zoomPage = "zoom"
+
+// zoomState is the widget displayed full-screen, and the size it had in the grid
+type zoomState struct {
+	widget wtf.Wtfable
+
+	height int
+	width  int
+
+	// The size the widget was last told it has while zoomed
+	zoomedHeight int
+	zoomedWidth  int
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// toggleZoom displays the focused widget full-screen, or puts the zoomed widget back in
+// the grid
+func (wtfApp *WtfApp) toggleZoom() {
+	if wtfApp.zoomed != nil {
+		wtfApp.unzoom()
+		return
+	}
+
+	wtfApp.zoom(wtfApp.focusTracker.focused())
+}
+
+// zoom displays the widget's view on a page of its own that fills the screen. The grid
+// is hidden while it's zoomed, so that it doesn't also draw the view in its cell
+func (wtfApp *WtfApp) zoom(widget wtf.Wtfable) {
+	if widget == nil {
+		return
+	}
+
+	view := widget.TextView()
+	_, _, width, height := view.GetInnerRect()
+
+	state := &zoomState{widget: widget, height: height, width: width}
+	wtfApp.zoomed = state
+
+	// The view fills the page, and the page fills the screen, so the size the widget has
+	// to render for is known when the page is drawn, and again whenever the terminal is
+	// resized
+	page := tview.NewFlex()
+	page.AddItem(view, 0, 1, true)
+	page.SetDrawFunc(func(_ tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		innerWidth, innerHeight := width, height
+		if widget.CommonSettings().Bordered {
+			innerWidth, innerHeight = width-2, height-2
+		}
+
+		if innerWidth != state.zoomedWidth || innerHeight != state.zoomedHeight {
+			state.zoomedWidth, state.zoomedHeight = innerWidth, innerHeight
+			resizeWidget(widget, innerWidth, innerHeight)
+		}
+
+		return x, y, width, height
+	})
+
+	wtfApp.pages.AddPage(zoomPage, page, true, true)
+	wtfApp.pages.HidePage(gridPage)
+	wtfApp.TViewApp.SetFocus(view)
+}
+
+// unzoom puts the zoomed widget back in the grid, at the size it had there
+func (wtfApp *WtfApp) unzoom() {
+	state := wtfApp.zoomed
+	if state == nil {
+		return
+	}
+
+	wtfApp.zoomed = nil
+
+	wtfApp.pages.RemovePage(zoomPage)
+	wtfApp.pages.ShowPage(gridPage)
+	wtfApp.pages.SendToBack(gridPage)
+
+	resizeWidget(state.widget, state.width, state.height)
+}
+
+// zoomOnFocused keeps the zoom on the focused widget when focus moves to another one
+func (wtfApp *WtfApp) zoomOnFocused() {
+	if wtfApp.zoomed == nil {
+		return
+	}
+
+	focused := wtfApp.focusTracker.focused()
+	if focused == wtfApp.zoomed.widget {
+		return
+	}
+
+	wtfApp.unzoom()
+	wtfApp.zoom(focused)
+}
+
+// resizeWidget tells a widget that renders for its size what its new size is. Widgets
+// usually refresh when they're resized, so it's done off the tview goroutine
+func resizeWidget(widget wtf.Wtfable, width, height int) {
+	resizable, ok := widget.(wtf.Resizable)
+	if !ok || width <= 0 || height <= 0 {
+		return
+	}
+
+	go resizable.Resize(width, height)
+}
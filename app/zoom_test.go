@@ -0,0 +1,41 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toggleZoom(t *testing.T) {
+	cfg, _ := config.ParseYaml(headlessConfig + `
+      focusable: true`)
+
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+	defer wtfApp.Stop()
+
+	t.Run("without a focused widget", func(t *testing.T) {
+		wtfApp.toggleZoom()
+
+		assert.Nil(t, wtfApp.zoomed)
+		assert.False(t, wtfApp.pages.HasPage(zoomPage))
+	})
+
+	t.Run("with a focused widget", func(t *testing.T) {
+		wtfApp.focusTracker.Next()
+
+		wtfApp.toggleZoom()
+
+		assert.NotNil(t, wtfApp.zoomed)
+		assert.Equal(t, wtfApp.widgets[0], wtfApp.zoomed.widget)
+		assert.True(t, wtfApp.pages.HasPage(zoomPage))
+		assert.Equal(t, zoomPage, frontPage(wtfApp.pages))
+
+		wtfApp.toggleZoom()
+
+		assert.Nil(t, wtfApp.zoomed)
+		assert.False(t, wtfApp.pages.HasPage(zoomPage))
+		assert.Equal(t, gridPage, frontPage(wtfApp.pages))
+	})
+}
@@ -0,0 +1,115 @@
+// Package cache stores the data modules last fetched successfully, so that it can be
+// displayed straight away the next time WTF starts, and when the data source can't be
+// reached. Entries are JSON files in the cache directory of the config directory.
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// cacheDir is the name of the directory in the config directory entries are stored in
+const cacheDir = "cache"
+
+// unsafeChars matches the characters that aren't allowed in an entry's file name
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// entry is the format entries are stored in
+type entry struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Data    json.RawMessage `json:"data"`
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Dir returns the absolute path to the directory entries are stored in
+func Dir() (string, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, cacheDir), nil
+}
+
+// Load reads the entry stored under key into data, and returns when it was saved. The
+// error satisfies os.IsNotExist when nothing has been stored under key
+func Load(key string, data interface{}) (time.Time, error) {
+	path, err := entryPath(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	stored := entry{}
+	if err := json.Unmarshal(contents, &stored); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := json.Unmarshal(stored.Data, data); err != nil {
+		return time.Time{}, err
+	}
+
+	return stored.SavedAt, nil
+}
+
+// Save stores data under key, replacing what was stored there before. The entry is
+// written to a temporary file first, so a reader never sees half of it
+func Save(key string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.Marshal(entry{SavedAt: time.Now(), Data: encoded})
+	if err != nil {
+		return err
+	}
+
+	path, err := entryPath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(contents); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// entryPath returns the path of the file the entry stored under key is kept in
+func entryPath(key string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, unsafeChars.ReplaceAllString(key, "_")+".json"), nil
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SaveLoad(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	type payload struct {
+		Jobs []string `json:"jobs"`
+	}
+
+	loaded := payload{}
+	_, err := Load("jenkins-builds", &loaded)
+	assert.True(t, os.IsNotExist(err))
+
+	before := time.Now()
+	assert.NoError(t, Save("jenkins-builds", payload{Jobs: []string{"api", "web"}}))
+	assert.NoError(t, Save("jenkins-builds", payload{Jobs: []string{"api"}}))
+
+	savedAt, err := Load("jenkins-builds", &loaded)
+	assert.NoError(t, err)
+	assert.Equal(t, payload{Jobs: []string{"api"}}, loaded)
+	assert.False(t, savedAt.Before(before.Truncate(time.Second)))
+
+	dir, err := Dir()
+	assert.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+}
+
+func Test_entryPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	path, err := entryPath("urlcheck-../sites down")
+	assert.NoError(t, err)
+	assert.Equal(t, "urlcheck-.._sites_down.json", filepath.Base(path))
+}
@@ -0,0 +1,122 @@
+package cfg
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/notify"
+)
+
+const alertsConfigPath = "wtf.alerts"
+
+/* -------------------- Exported Functions -------------------- */
+
+// ConfigureAlerts sets up the alert rules in `wtf.alerts`, which decide which of the
+// events modules raise are delivered, and how. Rules that can't be read are skipped.
+// For example:
+//
+//	alerts:
+//	  - name: failing builds
+//	    modules: [jenkins, circleci]
+//	    kinds: [build-failed]
+//	    notify: [bell, desktop]
+//	  - name: sites down
+//	    modules: urlcheck
+//	    severity: error
+//	    notify: webhook
+//	    url: https://hooks.example.com/wtf
+//	    repeat: 1h
+func ConfigureAlerts(config *config.Config) {
+	rules := []notify.Rule{}
+
+	items, _ := config.List(alertsConfigPath)
+	for idx := range items {
+		rule, err := NewAlertRule(config, fmt.Sprintf("%s.%d", alertsConfigPath, idx))
+		if err != nil {
+			logger.Warn(fmt.Sprintf("Alert %d is ignored: %s", idx+1, err))
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	notify.Configure(rules)
+}
+
+// NewAlertRule reads the alert rule at the given path of the config
+func NewAlertRule(config *config.Config, path string) (notify.Rule, error) {
+	rule := notify.Rule{
+		Name:    config.UString(path + ".name"),
+		Command: stringOrList(config, path+".command"),
+		Kinds:   stringOrList(config, path+".kinds"),
+		Modules: stringOrList(config, path+".modules"),
+		Repeat:  ParseTimeString(config, path+".repeat", "0"),
+		URL:     config.UString(path + ".url"),
+	}
+
+	rule.Channels = stringOrList(config, path+".notify")
+	if len(rule.Channels) == 0 {
+		return rule, fmt.Errorf("notify is missing, it should list some of %v", notify.Channels())
+	}
+
+	for _, channel := range rule.Channels {
+		if !contains(notify.Channels(), channel) {
+			return rule, fmt.Errorf("unknown notify channel %q, expected one of %v", channel, notify.Channels())
+		}
+
+		if channel == notify.ChannelWebhook && rule.URL == "" {
+			return rule, fmt.Errorf("the webhook channel needs a url")
+		}
+	}
+
+	if name := config.UString(path+".severity", ""); name != "" {
+		severity, err := notify.ParseSeverity(name)
+		if err != nil {
+			return rule, err
+		}
+		rule.Severity = severity
+	}
+
+	if pattern := config.UString(path+".match", ""); pattern != "" {
+		match, err := regexp.Compile(pattern)
+		if err != nil {
+			return rule, fmt.Errorf("match: %w", err)
+		}
+		rule.Match = match
+	}
+
+	return rule, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringOrList reads a config value that's either a single string or a list of them
+func stringOrList(config *config.Config, path string) []string {
+	if value, err := config.String(path); err == nil {
+		if value == "" {
+			return []string{}
+		}
+		return []string{value}
+	}
+
+	values := []string{}
+
+	items, _ := config.List(path)
+	for _, item := range items {
+		values = append(values, fmt.Sprint(item))
+	}
+
+	return values
+}
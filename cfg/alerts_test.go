@@ -0,0 +1,79 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/notify"
+)
+
+func Test_NewAlertRule(t *testing.T) {
+	tests := []struct {
+		name        string
+		yaml        string
+		expectedErr string
+		expected    notify.Rule
+	}{
+		{
+			name: "with lists",
+			yaml: "name: builds\nmodules: [jenkins, circleci]\nkinds: [build-failed]\nnotify: [bell, osc9]\nrepeat: 1h",
+			expected: notify.Rule{
+				Name:     "builds",
+				Channels: []string{"bell", "osc9"},
+				Command:  []string{},
+				Kinds:    []string{"build-failed"},
+				Modules:  []string{"jenkins", "circleci"},
+				Repeat:   time.Hour,
+			},
+		},
+		{
+			name: "with single values",
+			yaml: "modules: urlcheck\nnotify: desktop\nseverity: warning\ncommand: [say, '{title}']",
+			expected: notify.Rule{
+				Channels: []string{"desktop"},
+				Command:  []string{"say", "{title}"},
+				Kinds:    []string{},
+				Modules:  []string{"urlcheck"},
+				Severity: notify.SeverityWarn,
+			},
+		},
+		{
+			name:        "without notify",
+			yaml:        "modules: jenkins",
+			expectedErr: "notify is missing, it should list some of [bell desktop osc9 osc777 webhook]",
+		},
+		{
+			name:        "with a webhook but no url",
+			yaml:        "notify: webhook",
+			expectedErr: "the webhook channel needs a url",
+		},
+		{
+			name:        "with an unknown severity",
+			yaml:        "notify: bell\nseverity: critical",
+			expectedErr: `unknown severity "critical", expected info, warn or error`,
+		},
+		{
+			name:        "with a bad pattern",
+			yaml:        "notify: bell\nmatch: '(fail'",
+			expectedErr: "match: error parsing regexp: missing closing ): `(fail`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			rule, err := NewAlertRule(conf, "")
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rule)
+		})
+	}
+}
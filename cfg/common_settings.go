@@ -41,12 +41,21 @@ type Common struct {
 
 	DocPath string
 
-	Bordered        bool          `help:"Whether or not the module should be displayed with a border." values:"true, false" optional:"true" default:"true"`
-	Enabled         bool          `help:"Whether or not this module is executed and if its data displayed onscreen." values:"true, false" optional:"true" default:"false"`
-	Focusable       bool          `help:"Whether or  not this module is focusable." values:"true, false" optional:"true" default:"false"`
-	LanguageTag     string        `help:"The BCP 47 langauge tag to localize text to." values:"Any supported BCP 47 language tag." optional:"true" default:"en-CA"`
-	RefreshInterval time.Duration `help:"How often this module will update its data." values:"A positive integer followed by a time unit (ns, us or ÃÂµs, ms, s, m, h, or nothing which defaults to s)" optional:"true"`
-	Title           string        `help:"The title string to show when displaying this module" optional:"true"`
+	// Scope is where the module is configured: the path of the config file it's loaded
+	// from, followed by the names of the containers, such as groups, it's nested in.
+	// Module names are only unique within a scope
+	Scope string
+
+	Bordered        bool                        `help:"Whether or not the module should be displayed with a border." values:"true, false" optional:"true" default:"true"`
+	Cache           bool                        `help:"Whether modules that support it display the data they last fetched while they refresh, and when they can't." values:"true, false" optional:"true" default:"true"`
+	Enabled         bool                        `help:"Whether or not this module is executed and if its data displayed onscreen." values:"true, false" optional:"true" default:"false"`
+	Focusable       bool                        `help:"Whether or  not this module is focusable." values:"true, false" optional:"true" default:"false"`
+	Keys            KeyBindings                 `help:"Rebinds this module's keyboard commands, mapping the name of each action to a key or a list of keys." optional:"true"`
+	LanguageTag     string                      `help:"The BCP 47 langauge tag to localize text to." values:"Any supported BCP 47 language tag." optional:"true" default:"en-CA"`
+	Layouts         map[string]PositionSettings `help:"Where this module's widget is displayed in each of the named grid layouts in wtf.layouts, instead of its position." optional:"true"`
+	Priority        int                         `help:"The order flow layouts place widgets in, and groups order their tabs in, highest first." optional:"true" default:"0"`
+	RefreshInterval time.Duration               `help:"How often this module will update its data." values:"A positive integer followed by a time unit (ns, us or ÃÂµs, ms, s, m, h, or nothing which defaults to s)" optional:"true"`
+	Title           string                      `help:"The title string to show when displaying this module" optional:"true"`
 
 	focusChar int `help:"Define one of the number keys as a short cut key to access the widget." optional:"true"`
 }
@@ -72,12 +81,20 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 	// the colors extracted from the config file (aka colorsConfig)
 	defaultColorTheme := NewDefaultColorTheme()
 
+	baseColors.BorderTheme.Error = moduleConfig.UString("colors.border.error", colorsConfig.UString("border.error", defaultColorTheme.BorderTheme.Error))
 	baseColors.BorderTheme.Focusable = moduleConfig.UString("colors.border.focusable", colorsConfig.UString("border.focusable", defaultColorTheme.BorderTheme.Focusable))
 	baseColors.BorderTheme.Focused = moduleConfig.UString("colors.border.focused", colorsConfig.UString("border.focused", defaultColorTheme.BorderTheme.Focused))
 	baseColors.BorderTheme.Unfocusable = moduleConfig.UString("colors.border.normal", colorsConfig.UString("border.normal", defaultColorTheme.BorderTheme.Unfocusable))
 
 	baseColors.CheckboxTheme.Checked = moduleConfig.UString("colors.checked", colorsConfig.UString("checked", defaultColorTheme.CheckboxTheme.Checked))
 
+	baseColors.RoleTheme.Accent = moduleConfig.UString("colors.roles.accent", colorsConfig.UString("roles.accent", defaultColorTheme.RoleTheme.Accent))
+	baseColors.RoleTheme.Error = moduleConfig.UString("colors.roles.error", colorsConfig.UString("roles.error", defaultColorTheme.RoleTheme.Error))
+	baseColors.RoleTheme.Highlight = moduleConfig.UString("colors.roles.highlight", colorsConfig.UString("roles.highlight", defaultColorTheme.RoleTheme.Highlight))
+	baseColors.RoleTheme.Muted = moduleConfig.UString("colors.roles.muted", colorsConfig.UString("roles.muted", defaultColorTheme.RoleTheme.Muted))
+	baseColors.RoleTheme.Ok = moduleConfig.UString("colors.roles.ok", colorsConfig.UString("roles.ok", defaultColorTheme.RoleTheme.Ok))
+	baseColors.RoleTheme.Warn = moduleConfig.UString("colors.roles.warn", colorsConfig.UString("roles.warn", defaultColorTheme.RoleTheme.Warn))
+
 	baseColors.RowTheme.EvenForeground = moduleConfig.UString("colors.rows.even", colorsConfig.UString("rows.even", defaultColorTheme.RowTheme.EvenForeground))
 	baseColors.RowTheme.OddForeground = moduleConfig.UString("colors.rows.odd", colorsConfig.UString("rows.odd", defaultColorTheme.RowTheme.OddForeground))
 
@@ -99,10 +116,14 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 		PositionSettings: NewPositionSettingsFromYAML(moduleConfig),
 
 		Bordered:        moduleConfig.UBool("border", true),
+		Cache:           moduleConfig.UBool("cache", true),
 		Config:          moduleConfig,
 		Enabled:         moduleConfig.UBool("enabled", false),
 		Focusable:       moduleConfig.UBool("focusable", defaultFocusable),
+		Keys:            NewKeyBindingsFromYAML(moduleConfig, globalConfig),
 		LanguageTag:     globalConfig.UString("wtf.language", defaultLanguageTag),
+		Layouts:         NewLayoutPositionsFromYAML(moduleConfig),
+		Priority:        moduleConfig.UInt("priority", 0),
 		RefreshInterval: ParseTimeString(moduleConfig, "refreshInterval", "300s"),
 		Title:           moduleConfig.UString("title", defaultTitle),
 
@@ -209,6 +230,11 @@ func (common *Common) SetDocumentationPath(path string) {
 	common.DocPath = path
 }
 
+// SetScope sets where the module is configured, see Scope
+func (common *Common) SetScope(scope string) {
+	common.Scope = scope
+}
+
 // Validations aggregates all the validations from all the sub-sections in Common into a
 // single array of validations
 func (common *Common) Validations() []Validatable {
@@ -218,5 +244,11 @@ func (common *Common) Validations() []Validatable {
 		validatables = append(validatables, validation)
 	}
 
+	for _, position := range common.Layouts {
+		for _, validation := range position.Validations.validations {
+			validatables = append(validatables, validation)
+		}
+	}
+
 	return validatables
 }
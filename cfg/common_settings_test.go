@@ -191,5 +191,33 @@ func Test_PaginationMarker(t *testing.T) {
 }
 
 func Test_Validations(t *testing.T) {
-	assert.Equal(t, 4, len(testCfg.Validations()))
+	// A module without a position is placed by flow layouts, so there's nothing to validate
+	assert.Equal(t, 0, len(testCfg.Validations()))
+	assert.False(t, testCfg.Positioned)
+
+	positioned, _ := config.ParseYaml(`
+position:
+  top: 0
+  left: 0
+layouts:
+  laptop:
+    top: 1
+    left: 0
+    width: 1
+    height: 1
+`)
+	common := NewCommonSettingsFromModule("test", "Test Config", true, positioned, globalSettings)
+
+	assert.Equal(t, 8, len(common.Validations()))
+	assert.True(t, common.Positioned)
+
+	invalid := []string{}
+	for _, validation := range common.Validations() {
+		if validation.HasError() {
+			invalid = append(invalid, validation.String())
+		}
+	}
+	assert.Len(t, invalid, 2)
+
+	assert.Equal(t, PositionSettings{Validations: common.Layouts["laptop"].Validations, Top: 1, Width: 1, Height: 1, Positioned: true}, common.Layouts["laptop"])
 }
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
 )
 
 const (
@@ -87,12 +88,14 @@ func WtfConfigDir() (string, error) {
 	return configDir, nil
 }
 
-// LoadWtfConfigFile loads the specified config file
+// LoadWtfConfigFile loads the specified config file, merged with the files it includes
+// and the environment overlay selected with --env or WTF_ENV. See LoadConfigFragments.
+// The ${...} references in its values are left as they are, see InterpolateConfig. If the
+// config can't be loaded, it displays why and exits
 func LoadWtfConfigFile(filePath string) *config.Config {
-	absPath, _ := expandHomeDir(filePath)
-
-	cfg, err := config.ParseYamlFile(absPath)
+	cfg, err := ReadWtfConfigFile(filePath)
 	if err != nil {
+		absPath, _ := expandHomeDir(filePath)
 		displayWtfConfigFileLoadError(absPath, err)
 		os.Exit(1)
 	}
@@ -100,6 +103,56 @@ func LoadWtfConfigFile(filePath string) *config.Config {
 	return cfg
 }
 
+// ReadWtfConfigFile loads the specified config file like LoadWtfConfigFile does, but
+// returns the error if it can't be loaded
+func ReadWtfConfigFile(filePath string) (*config.Config, error) {
+	absPath, _ := expandHomeDir(filePath)
+
+	fragments, err := LoadConfigFragments(absPath, Environment())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &config.Config{Root: MergeConfigFragments(fragments)}
+
+	if err := ApplyTheme(cfg, filepath.Dir(absPath)); err != nil {
+		logger.Warn(fmt.Sprintf("Loading the theme failed, using the default colors: %s", err.Error()))
+	}
+
+	return cfg, nil
+}
+
+// WtfConfigFilePaths returns the paths of the files the specified config file is loaded
+// from: the file itself, the files it includes, those of the environment overlay and the
+// theme file, if the theme isn't a built-in one
+func WtfConfigFilePaths(filePath string) []string {
+	absPath, _ := expandHomeDir(filePath)
+
+	fragments, err := LoadConfigFragments(absPath, Environment())
+	if err != nil {
+		return []string{absPath}
+	}
+
+	paths := []string{}
+	seen := map[string]bool{}
+
+	for _, fragment := range fragments {
+		if !seen[fragment.Path] {
+			paths = append(paths, fragment.Path)
+			seen[fragment.Path] = true
+		}
+	}
+
+	cfg := &config.Config{Root: MergeConfigFragments(fragments)}
+	if theme := cfg.UString(themeConfigPath); theme != "" {
+		if themePath, err := ThemeFilePath(theme, filepath.Dir(absPath)); err == nil && themePath != "" {
+			paths = append(paths, themePath)
+		}
+	}
+
+	return paths
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 // chmodConfigFile sets the mode of the config file to r+w for the owner only
@@ -0,0 +1,98 @@
+package cfg
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// Dashboard defines a named configuration file whose widgets are displayed together,
+// separately from the widgets of any other dashboard
+type Dashboard struct {
+	Name           string
+	ConfigFilePath string
+}
+
+// Dashboards returns the list of dashboards defined by the given configuration.
+//
+// The configuration file itself is the first dashboard if it defines any modules. Additional
+// dashboards are listed under `wtf.dashboards`, either as a map of names to config files:
+//
+//	wtf:
+//	  dashboards:
+//	    oncall: "~/.config/wtf/oncall.yml"
+//	    dev: "dev.yml"
+//
+// or as a list of config files, in which case each is named after its file:
+//
+//	wtf:
+//	  dashboards:
+//	    - "~/.config/wtf/oncall.yml"
+//	    - "dev.yml"
+//
+// Relative paths are resolved against the directory of the configuration file.
+func Dashboards(globalConfig *config.Config, configFilePath string) []Dashboard {
+	dashboards := []Dashboard{}
+
+	if mods, err := globalConfig.Map("wtf.mods"); err == nil && len(mods) > 0 {
+		dashboards = append(dashboards, Dashboard{
+			Name:           dashboardName(configFilePath),
+			ConfigFilePath: configFilePath,
+		})
+	}
+
+	baseDir := filepath.Dir(configFilePath)
+
+	if named, err := globalConfig.Map("wtf.dashboards"); err == nil {
+		names := make([]string, 0, len(named))
+		for name := range named {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			path, ok := named[name].(string)
+			if !ok {
+				continue
+			}
+
+			dashboards = append(dashboards, Dashboard{
+				Name:           name,
+				ConfigFilePath: dashboardPath(baseDir, path),
+			})
+		}
+
+		return dashboards
+	}
+
+	for _, item := range globalConfig.UList("wtf.dashboards") {
+		path, ok := item.(string)
+		if !ok {
+			continue
+		}
+
+		dashboards = append(dashboards, Dashboard{
+			Name:           dashboardName(path),
+			ConfigFilePath: dashboardPath(baseDir, path),
+		})
+	}
+
+	return dashboards
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func dashboardName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func dashboardPath(baseDir, path string) string {
+	if strings.HasPrefix(path, "~") || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(baseDir, path)
+}
@@ -0,0 +1,56 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Dashboards(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected []Dashboard
+	}{
+		{
+			name:     "with no modules and no dashboards",
+			yaml:     "wtf:\n  grid:\n    columns: [10]",
+			expected: []Dashboard{},
+		},
+		{
+			name: "with modules only",
+			yaml: "wtf:\n  mods:\n    clocks:\n      enabled: true",
+			expected: []Dashboard{
+				{Name: "config", ConfigFilePath: "/home/wtf/config.yml"},
+			},
+		},
+		{
+			name: "with a map of dashboards",
+			yaml: "wtf:\n  dashboards:\n    oncall: oncall.yml\n    dev: /etc/wtf/dev.yml",
+			expected: []Dashboard{
+				{Name: "dev", ConfigFilePath: "/etc/wtf/dev.yml"},
+				{Name: "oncall", ConfigFilePath: "/home/wtf/oncall.yml"},
+			},
+		},
+		{
+			name: "with modules and a list of dashboards",
+			yaml: "wtf:\n  mods:\n    clocks:\n      enabled: true\n  dashboards:\n    - ci.yml\n    - ~/personal.yml",
+			expected: []Dashboard{
+				{Name: "config", ConfigFilePath: "/home/wtf/config.yml"},
+				{Name: "ci", ConfigFilePath: "/home/wtf/ci.yml"},
+				{Name: "personal", ConfigFilePath: "~/personal.yml"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ymlConfig, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			actual := Dashboards(ymlConfig, "/home/wtf/config.yml")
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
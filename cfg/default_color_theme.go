@@ -7,6 +7,7 @@ import (
 
 // BorderTheme defines the default color scheme for drawing widget borders
 type BorderTheme struct {
+	Error       string
 	Focusable   string
 	Focused     string
 	Unfocusable string
@@ -17,6 +18,17 @@ type CheckboxTheme struct {
 	Checked string
 }
 
+// RoleTheme defines the colors of the semantic roles that modules color their content
+// by, rather than naming colors themselves, so that themes can restyle them
+type RoleTheme struct {
+	Accent    string
+	Error     string
+	Highlight string
+	Muted     string
+	Ok        string
+	Warn      string
+}
+
 // RowTheme defines the default color scheme for row text
 type RowTheme struct {
 	EvenBackground string
@@ -46,6 +58,7 @@ type WidgetTheme struct {
 type ColorTheme struct {
 	BorderTheme
 	CheckboxTheme
+	RoleTheme
 	RowTheme
 	TextTheme
 	WidgetTheme
@@ -55,6 +68,7 @@ type ColorTheme struct {
 func NewDefaultColorTheme() ColorTheme {
 	defaultTheme := ColorTheme{
 		BorderTheme: BorderTheme{
+			Error:       "red",
 			Focusable:   "blue",
 			Focused:     "orange",
 			Unfocusable: "gray",
@@ -64,6 +78,15 @@ func NewDefaultColorTheme() ColorTheme {
 			Checked: "gray",
 		},
 
+		RoleTheme: RoleTheme{
+			Accent:    "blue",
+			Error:     "red",
+			Highlight: "lime",
+			Muted:     "gray",
+			Ok:        "green",
+			Warn:      "yellow",
+		},
+
 		RowTheme: RowTheme{
 			EvenBackground: "transparent",
 			EvenForeground: "white",
@@ -90,6 +113,21 @@ func NewDefaultColorTheme() ColorTheme {
 	return defaultTheme
 }
 
+// NewGlobalRoleTheme returns the colors of the semantic roles set in `wtf.colors.roles`,
+// for the parts of the app that don't belong to any one module
+func NewGlobalRoleTheme(globalConfig *config.Config) RoleTheme {
+	roles := NewDefaultColorTheme().RoleTheme
+
+	roles.Accent = globalConfig.UString("wtf.colors.roles.accent", roles.Accent)
+	roles.Error = globalConfig.UString("wtf.colors.roles.error", roles.Error)
+	roles.Highlight = globalConfig.UString("wtf.colors.roles.highlight", roles.Highlight)
+	roles.Muted = globalConfig.UString("wtf.colors.roles.muted", roles.Muted)
+	roles.Ok = globalConfig.UString("wtf.colors.roles.ok", roles.Ok)
+	roles.Warn = globalConfig.UString("wtf.colors.roles.warn", roles.Warn)
+
+	return roles
+}
+
 // NewDefaultColorConfig creates and returns a config.Config-compatible configuration struct
 // using a DefaultColorTheme to pre-populate all the relevant values
 func NewDefaultColorConfig() (*config.Config, error) {
@@ -3,6 +3,7 @@ package cfg
 import (
 	"testing"
 
+	"github.com/olebedev/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,6 +13,21 @@ func Test_NewDefaultColorTheme(t *testing.T) {
 	assert.Equal(t, "orange", theme.BorderTheme.Focused)
 	assert.Equal(t, "red", theme.TextTheme.Subheading)
 	assert.Equal(t, "transparent", theme.WidgetTheme.Background)
+	assert.Equal(t, "green", theme.RoleTheme.Ok)
+}
+
+func Test_NewGlobalRoleTheme(t *testing.T) {
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  colors:
+    roles:
+      ok: "#859900"
+`)
+
+	roles := NewGlobalRoleTheme(globalConfig)
+
+	assert.Equal(t, "#859900", roles.Ok)
+	assert.Equal(t, "red", roles.Error)
 }
 
 func Test_NewDefaultColorConfig(t *testing.T) {
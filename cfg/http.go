@@ -0,0 +1,78 @@
+package cfg
+
+import (
+	"fmt"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/httpclient"
+	"github.com/wtfutil/wtf/logger"
+)
+
+const httpConfigPath = "wtf.http"
+
+// ConfigureHTTP sets up the HTTP clients modules make their requests with from the
+// `wtf.http` section of the configuration:
+//
+//	wtf:
+//	  http:
+//	    caFile: "~/certs/corp-ca.pem"       # certificate authorities to trust besides the system's
+//	    clientCert: "~/certs/wtf.pem"       # presented to servers that ask for a client certificate
+//	    clientKey: "~/certs/wtf-key.pem"
+//	    proxy: "http://proxy.corp:3128"     # defaults to the HTTP_PROXY and HTTPS_PROXY variables
+//	    timeout: "30s"
+//	    userAgent: "wtf"
+//	    hosts:
+//	      - host: "*.atlassian.net"
+//	        rateLimit: 2                    # requests a second
+//	        burst: 5                        # requests allowed at once
+//	        retries: 3                      # for network errors and 429, 502, 503 and 504 responses
+//	        retryWait: "1s"                 # doubled after each retry
+func ConfigureHTTP(config *config.Config) {
+	err := httpclient.Configure(NewHTTPOptions(config))
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Some of the HTTP settings are ignored: %s", err))
+	}
+}
+
+// NewHTTPOptions reads the HTTP client settings from `wtf.http`
+func NewHTTPOptions(config *config.Config) httpclient.Options {
+	opts := httpclient.Options{
+		CAFile:    httpFilePath(config, httpConfigPath+".caFile"),
+		CertFile:  httpFilePath(config, httpConfigPath+".clientCert"),
+		KeyFile:   httpFilePath(config, httpConfigPath+".clientKey"),
+		Proxy:     config.UString(httpConfigPath+".proxy", ""),
+		Timeout:   ParseTimeString(config, httpConfigPath+".timeout", httpclient.DefaultTimeout.String()),
+		UserAgent: config.UString(httpConfigPath+".userAgent", ""),
+	}
+
+	items, _ := config.List(httpConfigPath + ".hosts")
+	for idx := range items {
+		path := fmt.Sprintf("%s.hosts.%d", httpConfigPath, idx)
+
+		opts.Hosts = append(opts.Hosts, httpclient.HostOptions{
+			Pattern:   config.UString(path+".host", ""),
+			Burst:     config.UInt(path+".burst", 1),
+			RateLimit: config.UFloat64(path+".rateLimit", 0),
+			Retries:   config.UInt(path+".retries", 0),
+			RetryWait: ParseTimeString(config, path+".retryWait", "1s"),
+		})
+	}
+
+	return opts
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// httpFilePath reads a file path setting, expanding the home directory in it
+func httpFilePath(config *config.Config, path string) string {
+	filePath := config.UString(path, "")
+	if filePath == "" {
+		return ""
+	}
+
+	if absPath, err := expandHomeDir(filePath); err == nil {
+		return absPath
+	}
+
+	return filePath
+}
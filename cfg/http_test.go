@@ -0,0 +1,49 @@
+package cfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/httpclient"
+)
+
+func Test_NewHTTPOptions(t *testing.T) {
+	t.Run("without settings", func(t *testing.T) {
+		conf, _ := config.ParseYaml("wtf:\n  mods: {}")
+
+		assert.Equal(t, httpclient.Options{Timeout: httpclient.DefaultTimeout}, NewHTTPOptions(conf))
+	})
+
+	t.Run("with settings", func(t *testing.T) {
+		conf, err := config.ParseYaml(`
+wtf:
+  http:
+    caFile: /etc/corp/ca.pem
+    proxy: http://proxy.corp:3128
+    timeout: 10
+    userAgent: wtf
+    hosts:
+      - host: "*.atlassian.net"
+        rateLimit: 0.5
+        retries: 3
+      - host: api.github.com
+        burst: 5
+        rateLimit: 2
+        retryWait: 500ms
+`)
+		assert.NoError(t, err)
+
+		assert.Equal(t, httpclient.Options{
+			CAFile:    "/etc/corp/ca.pem",
+			Proxy:     "http://proxy.corp:3128",
+			Timeout:   10 * time.Second,
+			UserAgent: "wtf",
+			Hosts: []httpclient.HostOptions{
+				{Pattern: "*.atlassian.net", Burst: 1, RateLimit: 0.5, Retries: 3, RetryWait: time.Second},
+				{Pattern: "api.github.com", Burst: 5, RateLimit: 2, RetryWait: 500 * time.Millisecond},
+			},
+		}, NewHTTPOptions(conf))
+	})
+}
@@ -0,0 +1,346 @@
+package cfg
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/olebedev/config"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvironmentVar is the environment variable that selects the environment overlay
+	// applied on top of the config, the same as the --env flag
+	EnvironmentVar = "WTF_ENV"
+
+	// anchorsKey holds the anchors defined in the files loaded before a file, so that it
+	// can refer to them
+	anchorsKey = "__wtf_anchors__"
+
+	// environmentsKey is the top-level key that maps environment names to overlays
+	environmentsKey = "environments"
+
+	// includeKey is the top-level key that lists the files a config file includes
+	includeKey = "include"
+)
+
+// ConfigFragment is one of the files, or one of the inline environment overlays, that a
+// config is merged from
+type ConfigFragment struct {
+	// Data is the YAML the fragment was parsed from: the file's contents, preceded by the
+	// anchors defined in the files loaded before it. It's empty for inline overlays
+	Data []byte
+
+	// Offset is the number of lines that precede the file's contents in Data
+	Offset int
+
+	// Path is the absolute path of the file, or of the file that defines the overlay
+	Path string
+
+	// Root is the parsed fragment
+	Root map[string]interface{}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Environment returns the name of the environment overlay to apply to the config, as set
+// with --env or WTF_ENV
+func Environment() string {
+	return os.Getenv(EnvironmentVar)
+}
+
+// LoadConfigFragments reads a config file and the files it includes, in the order they're
+// merged. A config file can include other files, and can include files itself. Included
+// files are merged in order, each over the ones before it, and the including file is
+// merged over them, so that its own settings win. Paths are relative to the including
+// file and can be globs, which are included in alphabetical order:
+//
+//	include:
+//	  - ~/src/team-dashboard/base.yml
+//	  - mods.d/*.yml
+//
+// Any of the files can define environment overlays, which are merged over everything else
+// when the named environment is selected. An overlay is either the settings to merge or
+// the files to include:
+//
+//	environments:
+//	  work:
+//	    wtf:
+//	      mods:
+//	        jira:
+//	          enabled: true
+//	  home: home.d/*.yml
+//
+// YAML anchors defined in a file can be referred to in the files loaded after it. Each
+// file is only loaded once, however many times it's included
+func LoadConfigFragments(filePath, environment string) ([]ConfigFragment, error) {
+	loader := &configLoader{loaded: map[string]bool{}}
+
+	if err := loader.load(filePath); err != nil {
+		return nil, err
+	}
+
+	if environment == "" {
+		return loader.fragments, nil
+	}
+
+	base := append([]ConfigFragment{}, loader.fragments...)
+
+	for _, fragment := range base {
+		environments, _ := fragment.Root[environmentsKey].(map[string]interface{})
+
+		overlay, ok := environments[environment]
+		if !ok {
+			continue
+		}
+
+		if settings, isMap := overlay.(map[string]interface{}); isMap {
+			loader.fragments = append(loader.fragments, ConfigFragment{Path: fragment.Path, Root: settings})
+			continue
+		}
+
+		paths, err := includePaths(filepath.Dir(fragment.Path), overlay)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s.%s: %w", fragment.Path, environmentsKey, environment, err)
+		}
+
+		for _, path := range paths {
+			if err := loader.load(path); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return loader.fragments, nil
+}
+
+// MergeConfigFragments deep-merges the fragments of a config, each over the ones before
+// it. Maps are merged key by key, anything else, including lists, is replaced
+func MergeConfigFragments(fragments []ConfigFragment) map[string]interface{} {
+	merged := map[string]interface{}{}
+
+	for _, fragment := range fragments {
+		mergeMaps(merged, fragment.Root)
+	}
+
+	delete(merged, environmentsKey)
+	delete(merged, includeKey)
+
+	return merged
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// configLoader loads config files in order, keeping track of the anchors they define
+type configLoader struct {
+	anchors   []*yaml.Node
+	fragments []ConfigFragment
+	loaded    map[string]bool
+}
+
+// collectAnchors remembers the anchored nodes in a file, so that the files loaded after
+// it can refer to them. Anchors nested inside an anchored node come along with it
+func (loader *configLoader) collectAnchors(node *yaml.Node) {
+	if node == nil || node.Kind == yaml.AliasNode {
+		return
+	}
+
+	if node.Anchor != "" {
+		loader.anchors = append(loader.anchors, node)
+		return
+	}
+
+	if node.Kind == yaml.MappingNode {
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			if node.Content[idx].Value == anchorsKey {
+				continue
+			}
+			loader.collectAnchors(node.Content[idx+1])
+		}
+		return
+	}
+
+	for _, child := range node.Content {
+		loader.collectAnchors(child)
+	}
+}
+
+func (loader *configLoader) load(path string) error {
+	if loader.loaded[path] {
+		return nil
+	}
+	loader.loaded[path] = true
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	fragment, node, err := loader.parse(path, data)
+	if err != nil {
+		return err
+	}
+
+	loader.collectAnchors(node)
+
+	paths, err := includePaths(filepath.Dir(path), fragment.Root[includeKey])
+	if err != nil {
+		return fmt.Errorf("%s: %s: %w", path, includeKey, err)
+	}
+
+	for _, include := range paths {
+		if err := loader.load(include); err != nil {
+			return err
+		}
+	}
+
+	// The file comes after the files it includes so that it's merged over them
+	loader.fragments = append(loader.fragments, fragment)
+
+	return nil
+}
+
+// parse parses a file, preceded by the anchors of the files loaded before it if it
+// refers to any of them
+func (loader *configLoader) parse(path string, data []byte) (ConfigFragment, *yaml.Node, error) {
+	fragment := ConfigFragment{Data: data, Path: path}
+
+	node := &yaml.Node{}
+	err := yaml.Unmarshal(data, node)
+
+	if err != nil && len(loader.anchors) > 0 {
+		preamble, preambleErr := anchorsPreamble(loader.anchors)
+		if preambleErr != nil {
+			return fragment, nil, fmt.Errorf("%s: %w", path, preambleErr)
+		}
+
+		combined := withPreamble(preamble, data)
+		combinedNode := &yaml.Node{}
+		if yaml.Unmarshal(combined, combinedNode) == nil {
+			fragment.Data = combined
+			fragment.Offset = bytes.Count(preamble, []byte("\n"))
+			node = combinedNode
+			err = nil
+		}
+	}
+
+	if err != nil {
+		return fragment, nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	parsed, err := config.ParseYamlBytes(fragment.Data)
+	if err != nil {
+		return fragment, nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	switch root := parsed.Root.(type) {
+	case nil:
+		fragment.Root = map[string]interface{}{}
+	case map[string]interface{}:
+		delete(root, anchorsKey)
+		fragment.Root = root
+	default:
+		return fragment, nil, fmt.Errorf("%s: expected a map of settings, found %v", path, root)
+	}
+
+	return fragment, node, nil
+}
+
+// anchorsPreamble returns YAML that defines the given anchors, under a key of its own
+func anchorsPreamble(anchors []*yaml.Node) ([]byte, error) {
+	preamble := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: anchorsKey},
+			{Kind: yaml.SequenceNode, Content: anchors},
+		},
+	}
+
+	return yaml.Marshal(preamble)
+}
+
+// includePaths turns the value of an include into the paths of the files to include,
+// resolving relative paths against dir and expanding globs
+func includePaths(dir string, value interface{}) ([]string, error) {
+	patterns := []string{}
+
+	switch value := value.(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		patterns = append(patterns, value)
+	case []interface{}:
+		for _, item := range value {
+			pattern, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a path, found %v", item)
+			}
+			patterns = append(patterns, pattern)
+		}
+	default:
+		return nil, fmt.Errorf("expected a path or a list of paths, found %v", value)
+	}
+
+	paths := []string{}
+
+	for _, pattern := range patterns {
+		path, err := expandHomeDir(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		if !strings.ContainsAny(path, "*?[") {
+			paths = append(paths, path)
+			continue
+		}
+
+		// A glob that matches nothing isn't an error, a directory of module files can be empty
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pattern, err)
+		}
+		paths = append(paths, matches...)
+	}
+
+	return paths, nil
+}
+
+// mergeMaps merges src into dst. Maps from src are copied rather than shared, so that
+// later merges don't change the fragment they came from
+func mergeMaps(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		if !srcIsMap {
+			dst[key] = value
+			continue
+		}
+
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if !dstIsMap {
+			dstMap = map[string]interface{}{}
+			dst[key] = dstMap
+		}
+
+		mergeMaps(dstMap, srcMap)
+	}
+}
+
+// withPreamble puts the preamble at the start of the document. A document start marker
+// is blanked out rather than removed, so that the file's lines keep their numbers
+func withPreamble(preamble, data []byte) []byte {
+	content := append([]byte{}, data...)
+
+	start := len(content) - len(bytes.TrimLeft(content, " \t\r\n"))
+	if bytes.HasPrefix(content[start:], []byte("---")) {
+		copy(content[start:], "   ")
+	}
+
+	return append(append([]byte{}, preamble...), content...)
+}
@@ -0,0 +1,199 @@
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeConfigFiles writes the files, named relative to a temporary directory, and returns
+// the directory
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	return dir
+}
+
+func loadMerged(t *testing.T, path, environment string) *config.Config {
+	fragments, err := LoadConfigFragments(path, environment)
+	assert.NoError(t, err)
+
+	return &config.Config{Root: MergeConfigFragments(fragments)}
+}
+
+func Test_LoadConfigFragments_Includes(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+include:
+  - team/base.yml
+  - mods.d/*.yml
+wtf:
+  grid:
+    columns: [20, 20]
+  mods:
+    clocks:
+      enabled: true
+      refreshInterval: 15
+`,
+		"team/base.yml": `
+wtf:
+  colors:
+    border:
+      normal: gray
+  mods:
+    clocks:
+      refreshInterval: 30
+      title: Team clocks
+`,
+		"mods.d/b_todo.yml": `
+wtf:
+  mods:
+    todo:
+      enabled: true
+`,
+		"mods.d/a_github.yml": `
+wtf:
+  mods:
+    github:
+      enabled: false
+    todo:
+      enabled: false
+`,
+	})
+
+	fragments, err := LoadConfigFragments(filepath.Join(dir, "config.yml"), "")
+	assert.NoError(t, err)
+
+	paths := []string{}
+	for _, fragment := range fragments {
+		rel, _ := filepath.Rel(dir, fragment.Path)
+		paths = append(paths, filepath.ToSlash(rel))
+	}
+	assert.Equal(t, []string{"team/base.yml", "mods.d/a_github.yml", "mods.d/b_todo.yml", "config.yml"}, paths)
+
+	merged := &config.Config{Root: MergeConfigFragments(fragments)}
+
+	// The including file's own settings win over those of the files it includes
+	assert.Equal(t, 15, merged.UInt("wtf.mods.clocks.refreshInterval"))
+	assert.Equal(t, true, merged.UBool("wtf.mods.clocks.enabled"))
+	assert.Equal(t, "Team clocks", merged.UString("wtf.mods.clocks.title"))
+	assert.Equal(t, "gray", merged.UString("wtf.colors.border.normal"))
+	assert.Equal(t, 20, merged.UInt("wtf.grid.columns.1"))
+	assert.Equal(t, true, merged.UBool("wtf.mods.todo.enabled"))
+	assert.Equal(t, false, merged.UBool("wtf.mods.github.enabled", true))
+
+	_, err = merged.Get("include")
+	assert.Error(t, err)
+}
+
+func Test_LoadConfigFragments_Environments(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+environments:
+  work:
+    wtf:
+      mods:
+        jira:
+          enabled: true
+  home: home.d/*.yml
+wtf:
+  mods:
+    jira:
+      enabled: false
+    todo:
+      enabled: false
+`,
+		"home.d/todo.yml": `
+wtf:
+  mods:
+    todo:
+      enabled: true
+`,
+	})
+
+	path := filepath.Join(dir, "config.yml")
+
+	base := loadMerged(t, path, "")
+	assert.False(t, base.UBool("wtf.mods.jira.enabled"))
+	assert.False(t, base.UBool("wtf.mods.todo.enabled"))
+
+	work := loadMerged(t, path, "work")
+	assert.True(t, work.UBool("wtf.mods.jira.enabled"))
+	assert.False(t, work.UBool("wtf.mods.todo.enabled"))
+
+	home := loadMerged(t, path, "home")
+	assert.False(t, home.UBool("wtf.mods.jira.enabled"))
+	assert.True(t, home.UBool("wtf.mods.todo.enabled"))
+
+	unknown := loadMerged(t, path, "nowhere")
+	assert.False(t, unknown.UBool("wtf.mods.jira.enabled"))
+}
+
+func Test_LoadConfigFragments_Anchors(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+defaults: &defaults
+  enabled: true
+  refreshInterval: 300
+include: mods.d/*.yml
+wtf:
+  mods: {}
+`,
+		"mods.d/github.yml": `---
+wtf:
+  mods:
+    github:
+      <<: *defaults
+      refreshInterval: 60
+`,
+	})
+
+	fragments, err := LoadConfigFragments(filepath.Join(dir, "config.yml"), "")
+	assert.NoError(t, err)
+	assert.Greater(t, fragments[0].Offset, 0)
+	assert.Equal(t, 0, fragments[1].Offset)
+
+	merged := &config.Config{Root: MergeConfigFragments(fragments)}
+
+	assert.True(t, merged.UBool("wtf.mods.github.enabled"))
+	assert.Equal(t, 60, merged.UInt("wtf.mods.github.refreshInterval"))
+
+	_, err = merged.Get(anchorsKey)
+	assert.Error(t, err)
+}
+
+func Test_LoadConfigFragments_Errors(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"missing.yml":  "include: nothere.yml\n",
+		"anchor.yml":   "wtf:\n  mods:\n    github: *nowhere\n",
+		"cycle.yml":    "include: cycle.yml\nwtf: {}\n",
+		"empty.yml":    "include: empty.d/*.yml\n",
+		"notalist.yml": "include: {a: b}\n",
+	})
+
+	_, err := LoadConfigFragments(filepath.Join(dir, "missing.yml"), "")
+	assert.Error(t, err)
+
+	_, err = LoadConfigFragments(filepath.Join(dir, "anchor.yml"), "")
+	assert.Error(t, err)
+
+	_, err = LoadConfigFragments(filepath.Join(dir, "notalist.yml"), "")
+	assert.Error(t, err)
+
+	fragments, err := LoadConfigFragments(filepath.Join(dir, "cycle.yml"), "")
+	assert.NoError(t, err)
+	assert.Len(t, fragments, 1)
+
+	fragments, err = LoadConfigFragments(filepath.Join(dir, "empty.yml"), "")
+	assert.NoError(t, err)
+	assert.Len(t, fragments, 1)
+}
@@ -0,0 +1,182 @@
+package cfg
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
+)
+
+// The references that can be used inside any config value, ie:
+//
+//	apiKey: "${env:GITHUB_TOKEN}"
+//	apiKey: "${file:~/.secrets/github}"
+//	apiKey: "${cmd:op read op://dev/github/token}"
+//
+// Writing $${ produces a literal ${ instead
+const (
+	interpolationCmd  = "cmd"
+	interpolationEnv  = "env"
+	interpolationFile = "file"
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// InterpolateConfig replaces the ${env:...}, ${file:...} and ${cmd:...} references in
+// every string value of the config with the values they refer to. A reference that can't
+// be resolved is replaced with an empty string and logged
+func InterpolateConfig(cfg *config.Config) {
+	cfg.Root = interpolateValue(cfg.Root)
+}
+
+// Interpolate replaces the ${env:...}, ${file:...} and ${cmd:...} references in a string
+// with the values they refer to. Anything else that looks like a reference, such as
+// ${HOME}, is left as it is
+func Interpolate(str string) (string, error) {
+	if !strings.Contains(str, "${") {
+		return str, nil
+	}
+
+	var result strings.Builder
+	var errs []string
+
+	for idx := 0; idx < len(str); {
+		if strings.HasPrefix(str[idx:], "$${") {
+			result.WriteString("${")
+			idx += 3
+			continue
+		}
+
+		if !strings.HasPrefix(str[idx:], "${") {
+			result.WriteByte(str[idx])
+			idx++
+			continue
+		}
+
+		end := closingBrace(str, idx+2)
+		if end < 0 {
+			result.WriteString(str[idx:])
+			break
+		}
+
+		ref := str[idx+2 : end]
+		value, handled, err := resolveReference(ref)
+
+		switch {
+		case !handled:
+			result.WriteString(str[idx : end+1])
+		case err != nil:
+			errs = append(errs, err.Error())
+		default:
+			result.WriteString(value)
+		}
+
+		idx = end + 1
+	}
+
+	if len(errs) > 0 {
+		return result.String(), fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return result.String(), nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// closingBrace returns the index of the brace that closes the reference starting at
+// start, allowing for balanced braces inside commands
+func closingBrace(str string, start int) int {
+	depth := 0
+
+	for idx := start; idx < len(str); idx++ {
+		switch str[idx] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return idx
+			}
+			depth--
+		}
+	}
+
+	return -1
+}
+
+func interpolateValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		interpolated, err := Interpolate(value)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("Could not interpolate config value: %s", err))
+		}
+		return interpolated
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = interpolateValue(item)
+		}
+		return value
+	case []interface{}:
+		for idx, item := range value {
+			value[idx] = interpolateValue(item)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// resolveReference returns the value of a single reference. References that aren't one
+// of the supported kinds aren't handled, and are left in the config as they are
+func resolveReference(ref string) (string, bool, error) {
+	kind, arg, found := strings.Cut(ref, ":")
+	if !found {
+		return "", false, nil
+	}
+
+	arg = strings.TrimSpace(arg)
+
+	switch kind {
+	case interpolationEnv:
+		value, ok := os.LookupEnv(arg)
+		if !ok {
+			return "", true, fmt.Errorf("environment variable %s is not set", arg)
+		}
+		return value, true, nil
+
+	case interpolationFile:
+		path, err := expandHomeDir(arg)
+		if err != nil {
+			return "", true, err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", true, err
+		}
+		return strings.TrimRight(string(data), "\r\n"), true, nil
+
+	case interpolationCmd:
+		output, err := shellCommand(arg).Output()
+		if err != nil {
+			return "", true, fmt.Errorf("%s: %w", arg, err)
+		}
+		return strings.TrimRight(string(output), "\r\n"), true, nil
+
+	default:
+		return "", false, nil
+	}
+}
+
+// shellCommand returns a command that runs the given command line in the user's shell
+func shellCommand(commandLine string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", commandLine)
+	}
+
+	return exec.Command("sh", "-c", commandLine)
+}
@@ -0,0 +1,76 @@
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Interpolate(t *testing.T) {
+	t.Setenv("WTF_TEST_TOKEN", "s3cret")
+
+	secretFile := filepath.Join(t.TempDir(), "token")
+	err := os.WriteFile(secretFile, []byte("from-file\n"), 0600)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "plain", input: "no references", expected: "no references"},
+		{name: "env", input: "${env:WTF_TEST_TOKEN}", expected: "s3cret"},
+		{name: "env inside text", input: "Bearer ${env:WTF_TEST_TOKEN}!", expected: "Bearer s3cret!"},
+		{name: "file", input: "${file:" + secretFile + "}", expected: "from-file"},
+		{name: "escaped", input: "$${env:WTF_TEST_TOKEN}", expected: "${env:WTF_TEST_TOKEN}"},
+		{name: "other references", input: "${HOME}/${unknown:thing}", expected: "${HOME}/${unknown:thing}"},
+		{name: "unterminated", input: "${env:WTF_TEST_TOKEN", expected: "${env:WTF_TEST_TOKEN"},
+		{name: "unset env", input: "a${env:WTF_TEST_UNSET}b", expected: "ab", wantErr: true},
+		{name: "missing file", input: "${file:" + secretFile + ".missing}", expected: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := Interpolate(tt.input)
+
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_Interpolate_Cmd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	actual, err := Interpolate("${cmd:echo {a,b} | tr -d ' '}")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "{a,b}", actual)
+}
+
+func Test_InterpolateConfig(t *testing.T) {
+	t.Setenv("WTF_TEST_TOKEN", "s3cret")
+
+	cfg, _ := config.ParseYaml(`
+wtf:
+  mods:
+    github:
+      apiKey: "${env:WTF_TEST_TOKEN}"
+      repositories:
+        - "${env:WTF_TEST_TOKEN}/wtf"
+      refreshInterval: 300
+`)
+
+	InterpolateConfig(cfg)
+
+	assert.Equal(t, "s3cret", cfg.UString("wtf.mods.github.apiKey"))
+	assert.Equal(t, "s3cret/wtf", cfg.UString("wtf.mods.github.repositories.0"))
+	assert.Equal(t, 300, cfg.UInt("wtf.mods.github.refreshInterval"))
+}
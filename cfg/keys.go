@@ -0,0 +1,130 @@
+package cfg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+)
+
+const keysConfigPath = "wtf.keys"
+
+// KeyBindings maps the names of keyboard actions to the keys the user has assigned them,
+// replacing the keys the action is bound to by default. An action mapped to no keys is
+// unbound
+type KeyBindings map[string][]string
+
+// NewKeyBindingsFromYAML returns the key bindings that apply to a module: those in the
+// global `wtf.keys` section, overridden by those in the module's own `keys` section
+func NewKeyBindingsFromYAML(moduleConfig *config.Config, globalConfig *config.Config) KeyBindings {
+	bindings := KeyBindings{}
+
+	if globalConfig != nil {
+		bindings.merge(globalConfig.UMap(keysConfigPath))
+	}
+
+	if moduleConfig != nil {
+		bindings.merge(moduleConfig.UMap("keys"))
+	}
+
+	return bindings
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Keys returns the keys the user has bound the action to, and whether they've rebound it
+// at all
+func (bindings KeyBindings) Keys(action string) ([]string, bool) {
+	keys, ok := bindings[action]
+	return keys, ok
+}
+
+// ActionName turns the help text of a keyboard command into the name it can be rebound
+// with in the config. Ie: "Select next item" becomes "select-next-item"
+func ActionName(helpText string) string {
+	words := strings.FieldsFunc(strings.ToLower(helpText), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	return strings.Join(words, "-")
+}
+
+// KeyBindingValues turns a config value into a list of keys. A binding can be a single
+// key or a list of them
+func KeyBindingValues(value interface{}) ([]string, error) {
+	switch value := value.(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		if value == "" {
+			return []string{}, nil
+		}
+		return []string{value}, nil
+	case []interface{}:
+		keys := []string{}
+		for _, item := range value {
+			if _, isList := item.([]interface{}); isList {
+				return nil, fmt.Errorf("expected a key name, got %v", item)
+			}
+
+			itemKeys, err := KeyBindingValues(item)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, itemKeys...)
+		}
+		return keys, nil
+	case int:
+		return []string{strconv.Itoa(value)}, nil
+	case bool:
+		// The YAML parser reads unquoted y, n, on, off, etc. as booleans
+		return nil, fmt.Errorf("expected a key name, got %v, keys such as y and n need to be quoted", value)
+	default:
+		return nil, fmt.Errorf("expected a key name or a list of key names, got %v", value)
+	}
+}
+
+// ParseKey parses the name of a key as it's written in the config. A single character
+// is that character, anything else is the name of a special key as listed in
+// tcell.KeyNames, ie: "Enter", "Ctrl-D", "Backtab". Names are matched without regard to
+// case. The returned name is the canonical one used in help text
+func ParseKey(name string) (tcell.Key, rune, string, error) {
+	if runes := []rune(name); len(runes) == 1 {
+		return tcell.KeyRune, runes[0], name, nil
+	}
+
+	for key, keyName := range tcell.KeyNames {
+		if strings.EqualFold(keyName, name) {
+			return key, 0, keyName, nil
+		}
+	}
+
+	return 0, 0, "", fmt.Errorf("unknown key: %s", name)
+}
+
+// EventKeyName returns the canonical name of the key pressed in a key event, matching
+// the names returned by ParseKey
+func EventKeyName(event *tcell.EventKey) string {
+	if event.Key() == tcell.KeyRune {
+		return string(event.Rune())
+	}
+
+	return tcell.KeyNames[event.Key()]
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (bindings KeyBindings) merge(values map[string]interface{}) {
+	for action, value := range values {
+		keys, err := KeyBindingValues(value)
+		if err != nil {
+			// Invalid bindings are reported by `wtfutil validate` and otherwise ignored
+			continue
+		}
+
+		bindings[action] = keys
+	}
+}
@@ -0,0 +1,62 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ActionName(t *testing.T) {
+	assert.Equal(t, "select-next-item", ActionName("Select next item"))
+	assert.Equal(t, "show-hide-this-help-prompt", ActionName("Show/hide this help prompt"))
+	assert.Equal(t, "open-moon-phase-for-today-in-browser", ActionName("Open 'Moon Phase for Today' in browser"))
+}
+
+func Test_NewKeyBindingsFromYAML(t *testing.T) {
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  keys:
+    quit: x
+    select-next-item: [j, Down]
+`)
+	moduleConfig, _ := config.ParseYaml(`
+keys:
+  select-next-item: "n"
+  refresh: ""
+`)
+
+	bindings := NewKeyBindingsFromYAML(moduleConfig, globalConfig)
+
+	keys, ok := bindings.Keys("quit")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"x"}, keys)
+
+	keys, ok = bindings.Keys("select-next-item")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"n"}, keys)
+
+	keys, ok = bindings.Keys("refresh")
+	assert.True(t, ok)
+	assert.Equal(t, []string{}, keys)
+
+	_, ok = bindings.Keys("docs")
+	assert.False(t, ok)
+}
+
+func Test_ParseKey(t *testing.T) {
+	key, char, name, err := ParseKey("j")
+	assert.NoError(t, err)
+	assert.Equal(t, tcell.KeyRune, key)
+	assert.Equal(t, 'j', char)
+	assert.Equal(t, "j", name)
+
+	key, _, name, err = ParseKey("ctrl-d")
+	assert.NoError(t, err)
+	assert.Equal(t, tcell.KeyCtrlD, key)
+	assert.Equal(t, "Ctrl-D", name)
+
+	_, _, _, err = ParseKey("Hyper-X")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package cfg
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
+)
+
+const (
+	defaultLogFile  = "log.txt"
+	defaultLogLevel = "info"
+)
+
+// ConfigureLogger sets up the logger from the `wtf.log` section of the configuration:
+//
+//	wtf:
+//	  log:
+//	    level: "info"       # one of debug, info, warn, error
+//	    maxBackups: 3       # the number of rotated log files to keep
+//	    maxSize: 5          # the size in megabytes the log can reach before it's rotated
+//	    path: "~/wtf.log"   # defaults to log.txt in the config directory
+func ConfigureLogger(config *config.Config) {
+	opts := logger.Options{
+		MaxBackups: config.UInt("wtf.log.maxBackups", logger.DefaultMaxBackups),
+		MaxSize:    int64(config.UInt("wtf.log.maxSize", 0)) * 1024 * 1024,
+		Path:       logFilePath(config),
+	}
+
+	level, err := logger.ParseLevel(config.UString("wtf.log.level", defaultLogLevel))
+	opts.Level = level
+
+	logger.Configure(opts)
+
+	if err != nil {
+		logger.Warn(fmt.Sprintf("%s, logging at %s", err, level))
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func logFilePath(config *config.Config) string {
+	if path := config.UString("wtf.log.path", ""); path != "" {
+		if absPath, err := expandHomeDir(path); err == nil {
+			return absPath
+		}
+		return path
+	}
+
+	configDir, err := WtfConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(configDir, defaultLogFile)
+}
@@ -1,10 +1,13 @@
 package cfg
 
 import (
+	"sort"
+
 	"github.com/olebedev/config"
 )
 
 const (
+	layoutsPath  = "layouts"
 	positionPath = "position"
 )
 
@@ -16,35 +19,80 @@ type PositionSettings struct {
 	Left   int
 	Top    int
 	Width  int
+
+	// Positioned is false when the module doesn't have a position at all, in which case
+	// flow layouts place it and grid layouts leave it out
+	Positioned bool
 }
 
 // NewPositionSettingsFromYAML creates and returns a new instance of cfg.Position
 func NewPositionSettingsFromYAML(moduleConfig *config.Config) PositionSettings {
+	return newPositionSettings(moduleConfig, positionPath, "")
+}
+
+// NewLayoutPositionsFromYAML returns the module's positions in each of the named grid
+// layouts defined in `wtf.layouts`, as set in its `layouts` section:
+//
+//	layouts:
+//	  laptop:
+//	    top: 0
+//	    left: 1
+//	    height: 2
+//	    width: 1
+func NewLayoutPositionsFromYAML(moduleConfig *config.Config) map[string]PositionSettings {
+	positions := map[string]PositionSettings{}
+
+	names := []string{}
+	for name := range moduleConfig.UMap(layoutsPath) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := layoutsPath + "." + name
+		positions[name] = newPositionSettings(moduleConfig, path, path+".")
+	}
+
+	return positions
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newPositionSettings reads the position at path. The names of its validations are given
+// the prefix, so that positions in different layouts can be told apart
+func newPositionSettings(moduleConfig *config.Config, path, prefix string) PositionSettings {
 	var currVal int
 	var err error
 
 	validations := NewValidations()
 
+	// A module doesn't need a position, flow layouts place it automatically
+	if _, err := moduleConfig.Get(path); err != nil {
+		return PositionSettings{Validations: validations}
+	}
+
 	// Parse the positional data from the config data
-	currVal, err = moduleConfig.Int(positionPath + ".top")
-	validations.append("top", newPositionValidation("top", currVal, err))
+	currVal, err = moduleConfig.Int(path + ".top")
+	validations.append(prefix+"top", newPositionValidation(prefix+"top", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".left")
-	validations.append("left", newPositionValidation("left", currVal, err))
+	currVal, err = moduleConfig.Int(path + ".left")
+	validations.append(prefix+"left", newPositionValidation(prefix+"left", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".width")
-	validations.append("width", newPositionValidation("width", currVal, err))
+	currVal, err = moduleConfig.Int(path + ".width")
+	validations.append(prefix+"width", newPositionValidation(prefix+"width", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".height")
-	validations.append("height", newPositionValidation("height", currVal, err))
+	currVal, err = moduleConfig.Int(path + ".height")
+	validations.append(prefix+"height", newPositionValidation(prefix+"height", currVal, err))
 
 	pos := PositionSettings{
 		Validations: validations,
 
-		Top:    validations.intValueFor("top"),
-		Left:   validations.intValueFor("left"),
-		Width:  validations.intValueFor("width"),
-		Height: validations.intValueFor("height"),
+		Top:    validations.intValueFor(prefix + "top"),
+		Left:   validations.intValueFor(prefix + "left"),
+		Width:  validations.intValueFor(prefix + "width"),
+		Height: validations.intValueFor(prefix + "height"),
+
+		Positioned: true,
 	}
 
 	return pos
@@ -0,0 +1,426 @@
+package cfg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/docker/docker-credential-helpers/client"
+	"github.com/olebedev/config"
+	"gopkg.in/yaml.v3"
+)
+
+// The names of the secret providers, as used in `wtf.secrets.providers`
+const (
+	SecretProviderEnv   = "env"
+	SecretProviderFile  = "file"
+	SecretProviderPass  = "pass"
+	SecretProviderStore = "store"
+)
+
+const (
+	defaultSecretEnvPrefix  = "WTF_SECRET_"
+	defaultSecretPassPrefix = "wtf/"
+	secretsConfigPath       = "wtf.secrets"
+)
+
+// SecretProvider looks up the secret saved for a service. A provider that doesn't have
+// a secret for the service returns found as false, an error means the provider itself
+// failed
+type SecretProvider interface {
+	Get(service string) (secret string, found bool, err error)
+	Name() string
+}
+
+// secretLister is a SecretProvider that can enumerate the services it has secrets for
+type secretLister interface {
+	List() ([]string, error)
+}
+
+// SecretProviders returns the secret providers configured in `wtf.secrets`, in the order
+// they're consulted. Unless `wtf.secrets.providers` says otherwise that's environment
+// variables first, then the secrets file, the pass password store and the credential
+// helper named by `wtf.secretStore`, each of the last three only if it's configured:
+//
+//	wtf:
+//	  secrets:
+//	    providers: [env, file, pass, store]
+//	    env:
+//	      prefix: WTF_SECRET_
+//	    file:
+//	      path: ~/.config/wtf/secrets.yml.age
+//	      identity: ~/.config/age/keys.txt
+//	    pass:
+//	      prefix: wtf/
+//	  secretStore: secretservice
+func SecretProviders(globalConfig *config.Config) []SecretProvider {
+	names := []string{}
+	for _, name := range globalConfig.UList(secretsConfigPath + ".providers") {
+		if str, ok := name.(string); ok {
+			names = append(names, str)
+		}
+	}
+
+	explicit := len(names) > 0
+	if !explicit {
+		names = []string{SecretProviderEnv, SecretProviderFile, SecretProviderPass, SecretProviderStore}
+	}
+
+	providers := []SecretProvider{}
+
+	for _, name := range names {
+		provider := newSecretProvider(name, globalConfig, explicit)
+		if provider != nil {
+			providers = append(providers, provider)
+		}
+	}
+
+	return providers
+}
+
+// SecretEnvName returns the environment variable the env provider reads the secret for a
+// service from: the prefix followed by the service, uppercased, with everything that isn't
+// a letter or a number replaced by underscores. Ie: "github" becomes WTF_SECRET_GITHUB
+func SecretEnvName(prefix, service string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, service)
+
+	return prefix + name
+}
+
+// SecretEnvVar returns the environment variable the env provider reads the secret for a
+// service from, using the prefix set in `wtf.secrets.env.prefix`
+func SecretEnvVar(globalConfig *config.Config, service string) string {
+	return SecretEnvName(secretEnvPrefix(globalConfig), service)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newSecretProvider creates the named provider. Providers that need configuration are
+// only created when they've been configured, or explicitly asked for
+func newSecretProvider(name string, globalConfig *config.Config, explicit bool) SecretProvider {
+	switch name {
+	case SecretProviderEnv:
+		return &envSecretProvider{
+			prefix: secretEnvPrefix(globalConfig),
+		}
+
+	case SecretProviderFile:
+		path := globalConfig.UString(secretsConfigPath + ".file.path")
+		if path == "" {
+			return nil
+		}
+
+		return &fileSecretProvider{
+			decrypt:  globalConfig.UString(secretsConfigPath + ".file.decrypt"),
+			identity: globalConfig.UString(secretsConfigPath + ".file.identity"),
+			path:     path,
+		}
+
+	case SecretProviderPass:
+		if _, err := globalConfig.Get(secretsConfigPath + ".pass"); err != nil && !explicit {
+			return nil
+		}
+
+		return &passSecretProvider{
+			prefix: globalConfig.UString(secretsConfigPath+".pass.prefix", defaultSecretPassPrefix),
+		}
+
+	case SecretProviderStore:
+		prog := newProgram(globalConfig)
+		if prog == nil {
+			return nil
+		}
+
+		return &storeSecretProvider{prog: prog}
+
+	default:
+		return nil
+	}
+}
+
+func secretEnvPrefix(globalConfig *config.Config) string {
+	return globalConfig.UString(secretsConfigPath+".env.prefix", defaultSecretEnvPrefix)
+}
+
+/* -------------------- Environment variables -------------------- */
+
+// envSecretProvider reads secrets from environment variables named after the service
+type envSecretProvider struct {
+	prefix string
+}
+
+func (provider *envSecretProvider) Get(service string) (string, bool, error) {
+	secret, ok := os.LookupEnv(SecretEnvName(provider.prefix, service))
+	if !ok || secret == "" {
+		return "", false, nil
+	}
+
+	return secret, true, nil
+}
+
+func (provider *envSecretProvider) Name() string {
+	return SecretProviderEnv
+}
+
+/* -------------------- Secrets file -------------------- */
+
+// ForgetSecretFiles drops the cached contents of the secrets files, so that they're read
+// and decrypted again the next time a secret is looked up, such as when the config is
+// reloaded
+func ForgetSecretFiles() {
+	decryptedSecretFiles.Lock()
+	defer decryptedSecretFiles.Unlock()
+
+	decryptedSecretFiles.secrets = map[string]map[string]string{}
+}
+
+// decryptedSecretFiles caches the contents of each secrets file, so that a passphrase is
+// only asked for once however many modules have secrets
+var decryptedSecretFiles = struct {
+	sync.Mutex
+	secrets map[string]map[string]string
+}{secrets: map[string]map[string]string{}}
+
+// fileSecretProvider reads secrets from a YAML file mapping services to secrets, which
+// can be encrypted with age or gpg
+type fileSecretProvider struct {
+	decrypt  string
+	identity string
+	path     string
+}
+
+func (provider *fileSecretProvider) Get(service string) (string, bool, error) {
+	secrets, err := provider.secrets()
+	if err != nil {
+		return "", false, err
+	}
+
+	secret, ok := secrets[service]
+	return secret, ok && secret != "", nil
+}
+
+func (provider *fileSecretProvider) List() ([]string, error) {
+	secrets, err := provider.secrets()
+	if err != nil {
+		return nil, err
+	}
+
+	services := []string{}
+	for service := range secrets {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+func (provider *fileSecretProvider) Name() string {
+	return SecretProviderFile
+}
+
+// decryption returns how the file is decrypted: "age", "gpg" or "none". Unless it's
+// configured, that's decided by the file's extension
+func (provider *fileSecretProvider) decryption() string {
+	if provider.decrypt != "" {
+		return provider.decrypt
+	}
+
+	switch filepath.Ext(provider.path) {
+	case ".age":
+		return "age"
+	case ".gpg", ".asc":
+		return "gpg"
+	default:
+		return "none"
+	}
+}
+
+func (provider *fileSecretProvider) read() ([]byte, error) {
+	path, err := expandHomeDir(provider.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cmd *exec.Cmd
+
+	switch provider.decryption() {
+	case "none":
+		return os.ReadFile(path)
+	case "age":
+		args := []string{"--decrypt"}
+		if provider.identity != "" {
+			identity, err := expandHomeDir(provider.identity)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, "--identity", identity)
+		}
+		cmd = exec.Command("age", append(args, path)...)
+	case "gpg":
+		cmd = exec.Command("gpg", "--quiet", "--batch", "--decrypt", path)
+	default:
+		return nil, fmt.Errorf("unknown secrets file decryption %q, expected age, gpg or none", provider.decrypt)
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %s: %w: %s", provider.path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return output, nil
+}
+
+func (provider *fileSecretProvider) secrets() (map[string]string, error) {
+	decryptedSecretFiles.Lock()
+	defer decryptedSecretFiles.Unlock()
+
+	if secrets, ok := decryptedSecretFiles.secrets[provider.path]; ok {
+		return secrets, nil
+	}
+
+	data, err := provider.read()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	if err := yaml.Unmarshal(data, &secrets); err != nil {
+		return nil, fmt.Errorf("read %s: %w", provider.path, err)
+	}
+
+	decryptedSecretFiles.secrets[provider.path] = secrets
+
+	return secrets, nil
+}
+
+/* -------------------- pass -------------------- */
+
+// passSecretProvider reads secrets from the pass password store, using the first line of
+// the entry named after the service
+type passSecretProvider struct {
+	prefix string
+}
+
+func (provider *passSecretProvider) Get(service string) (string, bool, error) {
+	entry := provider.prefix + service
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("pass", "show", entry)
+	cmd.Stderr = stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && strings.Contains(stderr.String(), "is not in the password store") {
+			return "", false, nil
+		}
+
+		return "", false, fmt.Errorf("pass show %s: %w: %s", entry, err, strings.TrimSpace(stderr.String()))
+	}
+
+	secret, _, _ := strings.Cut(string(output), "\n")
+	secret = strings.TrimRight(secret, "\r")
+
+	return secret, secret != "", nil
+}
+
+// List walks the password store's directory rather than decrypting any of its entries
+func (provider *passSecretProvider) List() ([]string, error) {
+	storeDir := os.Getenv("PASSWORD_STORE_DIR")
+	if storeDir == "" {
+		storeDir = "~/.password-store"
+	}
+
+	storeDir, err := expandHomeDir(storeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	services := []string{}
+	root := filepath.Join(storeDir, filepath.FromSlash(provider.prefix))
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if entry.IsDir() || filepath.Ext(path) != ".gpg" {
+			return nil
+		}
+
+		name, err := filepath.Rel(storeDir, strings.TrimSuffix(path, ".gpg"))
+		if err != nil {
+			return err
+		}
+
+		services = append(services, strings.TrimPrefix(filepath.ToSlash(name), provider.prefix))
+		return nil
+	})
+
+	return services, err
+}
+
+func (provider *passSecretProvider) Name() string {
+	return SecretProviderPass
+}
+
+/* -------------------- Credential helper -------------------- */
+
+// storeSecretProvider reads secrets from the docker-credential helper configured with
+// `wtf.secretStore`
+type storeSecretProvider struct {
+	prog *program
+}
+
+func (provider *storeSecretProvider) Get(service string) (string, bool, error) {
+	cred, err := client.Get(provider.prog.runner, service)
+	if err != nil {
+		if strings.Contains(err.Error(), "credentials not found") {
+			return "", false, nil
+		}
+
+		return "", false, fmt.Errorf("get %v from %v: %w", service, provider.prog.store, err)
+	}
+
+	return cred.Secret, cred.Secret != "", nil
+}
+
+// List returns every service in the credential helper, which can include credentials
+// saved by other programs
+func (provider *storeSecretProvider) List() ([]string, error) {
+	creds, err := client.List(provider.prog.runner)
+	if err != nil {
+		return nil, fmt.Errorf("list %v: %w", provider.prog.store, err)
+	}
+
+	services := []string{}
+	for service := range creds {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+func (provider *storeSecretProvider) Name() string {
+	return SecretProviderStore
+}
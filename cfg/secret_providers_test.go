@@ -0,0 +1,180 @@
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SecretEnvName(t *testing.T) {
+	assert.Equal(t, "WTF_SECRET_GITHUB", SecretEnvName(defaultSecretEnvPrefix, "github"))
+	assert.Equal(t, "WTF_SECRET_HTTPS___GITHUB_EXAMPLE_COM_API_V3", SecretEnvName(defaultSecretEnvPrefix, "https://github.example.com/api/v3"))
+	assert.Equal(t, "MY_TODOIST", SecretEnvName("MY_", "todoist"))
+}
+
+func Test_SecretProviders(t *testing.T) {
+	providerNames := func(yaml string) []string {
+		globalConfig, _ := config.ParseYaml(yaml)
+
+		names := []string{}
+		for _, provider := range SecretProviders(globalConfig) {
+			names = append(names, provider.Name())
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"env"}, providerNames("wtf: {}"))
+	assert.Equal(t, []string{"env", "file", "store"}, providerNames(`
+wtf:
+  secretStore: secretservice
+  secrets:
+    file:
+      path: secrets.yml
+`))
+	assert.Equal(t, []string{"pass", "env"}, providerNames(`
+wtf:
+  secrets:
+    providers: [pass, env, bogus]
+`))
+}
+
+func Test_FetchSecret(t *testing.T) {
+	secretsFile := filepath.Join(t.TempDir(), "secrets.yml")
+	err := os.WriteFile(secretsFile, []byte("github: from-file\ntodoist: from-file\n"), 0600)
+	assert.NoError(t, err)
+
+	t.Setenv("WTF_SECRET_GITHUB", "from-env")
+
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  secrets:
+    file:
+      path: ` + secretsFile + `
+`)
+
+	secret, err := FetchSecret(globalConfig, "github")
+	assert.NoError(t, err)
+	assert.Equal(t, &Secret{Service: "github", Secret: "from-env", Store: "env"}, secret)
+
+	secret, err = FetchSecret(globalConfig, "todoist")
+	assert.NoError(t, err)
+	assert.Equal(t, &Secret{Service: "todoist", Secret: "from-file", Store: "file"}, secret)
+
+	secret, err = FetchSecret(globalConfig, "jira")
+	assert.NoError(t, err)
+	assert.Nil(t, secret)
+
+	apiKey := ""
+	ModuleSecret("todoist", globalConfig, &apiKey).Load()
+	assert.Equal(t, "from-file", apiKey)
+}
+
+func Test_ListSecrets(t *testing.T) {
+	secretsFile := filepath.Join(t.TempDir(), "secrets.yml")
+	err := os.WriteFile(secretsFile, []byte("todoist: abc\ngithub: def\n"), 0600)
+	assert.NoError(t, err)
+
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  secrets:
+    file:
+      path: ` + secretsFile + `
+`)
+
+	listings, err := ListSecrets(globalConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []SecretListing{{Service: "github", Store: "file"}, {Service: "todoist", Store: "file"}}, listings)
+}
+
+func Test_ForgetSecretFiles(t *testing.T) {
+	secretsFile := filepath.Join(t.TempDir(), "secrets.yml")
+	err := os.WriteFile(secretsFile, []byte("todoist: old\n"), 0600)
+	assert.NoError(t, err)
+
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  secrets:
+    file:
+      path: ` + secretsFile + `
+`)
+
+	secret, err := FetchSecret(globalConfig, "todoist")
+	assert.NoError(t, err)
+	assert.Equal(t, "old", secret.Secret)
+
+	err = os.WriteFile(secretsFile, []byte("todoist: new\n"), 0600)
+	assert.NoError(t, err)
+
+	// The file is only read again once it's been forgotten
+	secret, _ = FetchSecret(globalConfig, "todoist")
+	assert.Equal(t, "old", secret.Secret)
+
+	ForgetSecretFiles()
+
+	secret, _ = FetchSecret(globalConfig, "todoist")
+	assert.Equal(t, "new", secret.Secret)
+}
+
+func Test_RecordSecretLookups(t *testing.T) {
+	t.Setenv("WTF_SECRET_GITHUB", "from-env")
+
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+
+	apiKey := ""
+	configured := "from-config"
+	missing := ""
+
+	lookups := RecordSecretLookups(func() {
+		ModuleSecret("github", globalConfig, &apiKey).Load()
+		ModuleSecret("jira", globalConfig, &configured).Service("https://jira.example.com").Load()
+		ModuleSecret("todoist", globalConfig, &missing).Load()
+	})
+
+	assert.Equal(t, []SecretLookup{
+		{Module: "github", Service: "github", Source: "env", Target: &apiKey},
+		{Module: "jira", Service: "https://jira.example.com", Source: SecretSourceConfig, Target: &configured},
+		{Module: "todoist", Service: "todoist", Source: "", Target: &missing},
+	}, lookups)
+	assert.Equal(t, "from-env", apiKey)
+
+	// Lookups outside of RecordSecretLookups aren't recorded
+	apiKey = ""
+	ModuleSecret("github", globalConfig, &apiKey).Load()
+	assert.Empty(t, RecordSecretLookups(func() {}))
+}
+
+func Test_RecordSecretReferences(t *testing.T) {
+	t.Setenv("WTF_SECRET_GITHUB", "from-env")
+
+	globalConfig, _ := config.ParseYaml(`
+wtf:
+  secretStore: spy
+  secrets:
+    providers: [env, store]
+`)
+
+	apiKey := ""
+	configured := "from-config"
+
+	lookups := RecordSecretReferences(func() {
+		ModuleSecret("github", globalConfig, &apiKey).Load()
+		ModuleSecret("jira", globalConfig, &configured).Load()
+	})
+
+	// The providers aren't asked, so the secret in the environment isn't found and the
+	// credential helper isn't run, which would have failed
+	assert.Equal(t, []SecretLookup{
+		{Module: "github", Service: "github", Source: "", Target: &apiKey},
+		{Module: "jira", Service: "jira", Source: SecretSourceConfig, Target: &configured},
+	}, lookups)
+	assert.Equal(t, "", apiKey)
+
+	// Once it's done the providers are asked again
+	lookups = RecordSecretLookups(func() {
+		ModuleSecret("github", globalConfig, &apiKey).Load()
+	})
+	assert.Equal(t, "env", lookups[0].Source)
+}
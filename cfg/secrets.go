@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/docker/docker-credential-helpers/client"
 	"github.com/docker/docker-credential-helpers/credentials"
@@ -21,6 +24,13 @@ type SecretLoadParams struct {
 
 // Load module secrets.
 //
+// Secrets are looked up by SERVICE in each of the configured secret
+// providers in turn: environment variables, a (possibly encrypted) secrets
+// file, the pass password store and the docker-credential helpers. See
+// SecretProviders for how they're configured. Any config value can also
+// refer to a secret directly with ${env:...}, ${file:...} or ${cmd:...},
+// see Interpolate.
+//
 // The credential helpers impose this structure:
 //
 //	SERVICE is mapped to a SECRET and USERNAME
@@ -90,11 +100,30 @@ func (slp *SecretLoadParams) Service(service string) *SecretLoadParams {
 }
 
 func (slp *SecretLoadParams) Load() {
-	configureSecret(
-		slp.globalConfig,
-		slp.service,
-		slp.secret,
-	)
+	var source string
+	var err error
+
+	if secretProvidersSkipped() {
+		source = configuredSecret(slp.secret)
+	} else {
+		source, err = configureSecret(
+			slp.globalConfig,
+			slp.service,
+			slp.secret,
+		)
+	}
+
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Loading secret failed: %s", err.Error()))
+	}
+
+	recordSecretLookup(SecretLookup{
+		Err:     err,
+		Module:  slp.name,
+		Service: slp.service,
+		Source:  source,
+		Target:  slp.secret,
+	})
 }
 
 type Secret struct {
@@ -104,65 +133,85 @@ type Secret struct {
 	Store    string
 }
 
+// SecretSourceConfig is the source of a secret that was set in the module's config
+const SecretSourceConfig = "config"
+
+// configureSecret fills in the secret for the service, unless it's already been
+// configured, and returns where it came from: SecretSourceConfig, the name of the
+// provider that had it, or "" if no provider had it
 func configureSecret(
 	globalConfig *config.Config,
 	service string,
 	secret *string,
-) {
+) (string, error) {
 	if service == "" {
-		return
+		return "", nil
 	}
 
 	if secret == nil {
-		return
+		return "", nil
 	}
 
 	// Don't overwrite the secret if it was configured with yaml
-	if *secret != "" {
-		return
+	if source := configuredSecret(secret); source != "" {
+		return source, nil
 	}
 
 	cred, err := FetchSecret(globalConfig, service)
 
 	if err != nil {
-		logger.Log(fmt.Sprintf("Loading secret failed: %s", err.Error()))
-		return
+		return "", err
 	}
 
 	if cred == nil {
-		// No secret store configued.
-		return
+		// No provider has a secret for the service
+		return "", nil
 	}
 
-	if secret != nil && *secret == "" {
-		*secret = cred.Secret
+	*secret = cred.Secret
+
+	return cred.Store, nil
+}
+
+// configuredSecret returns SecretSourceConfig if the secret was set in the module's
+// config, "" if it wasn't
+func configuredSecret(secret *string) string {
+	if secret == nil || *secret == "" {
+		return ""
 	}
+
+	return SecretSourceConfig
 }
 
 // Fetch secret for `service`. Service is customarily a URL, but can be any
 // identifier uniquely used by wtf to identify the service, such as the name
-// of the module.  nil is returned if the secretStore global property is not
-// present or the secret is not found in that store.
+// of the module. The secret providers are asked in turn, see SecretProviders,
+// and the first secret found is returned. nil is returned if no provider has
+// a secret for the service.
 func FetchSecret(globalConfig *config.Config, service string) (*Secret, error) {
-	prog := newProgram(globalConfig)
+	errs := []string{}
 
-	if prog == nil {
-		// No secret store configured.
-		return nil, nil
-	}
+	for _, provider := range SecretProviders(globalConfig) {
+		secret, found, err := provider.Get(service)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", provider.Name(), err.Error()))
+			continue
+		}
 
-	cred, err := client.Get(prog.runner, service)
+		if found {
+			return &Secret{
+				Service: service,
+				Secret:  secret,
+				Store:   provider.Name(),
+			}, nil
+		}
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("get %v from %v: %w", service, prog.store, err)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("get %v: %s", service, strings.Join(errs, "; "))
 	}
 
-	return &Secret{
-		Service:  cred.ServerURL,
-		Secret:   cred.Secret,
-		Username: cred.Username,
-		Store:    prog.store,
-	}, nil
+	return nil, nil
 }
 
 func StoreSecret(globalConfig *config.Config, secret *Secret) error {
@@ -193,6 +242,153 @@ func StoreSecret(globalConfig *config.Config, secret *Secret) error {
 	return nil
 }
 
+// DeleteSecret removes the secret for `service` from the secret store. Only
+// the credential helper configured with wtf.secretStore can be written to.
+func DeleteSecret(globalConfig *config.Config, service string) error {
+	prog := newProgram(globalConfig)
+
+	if prog == nil {
+		return errors.New("cannot delete secrets: wtf.secretStore is not configured")
+	}
+
+	err := client.Erase(prog.runner, service)
+
+	if err != nil {
+		return fmt.Errorf("delete %v from %v: %w", service, prog.store, err)
+	}
+
+	return nil
+}
+
+// SecretListing is a service that a secret provider has a secret for
+type SecretListing struct {
+	Service string
+	Store   string
+}
+
+// ListSecrets returns the services that the secret providers have secrets
+// for, sorted by service. Only the providers that can enumerate their
+// secrets are included: the secrets file, pass and the credential helper.
+// Secret values are never read from the credential helper or pass.
+func ListSecrets(globalConfig *config.Config) ([]SecretListing, error) {
+	listings := []SecretListing{}
+	errs := []string{}
+
+	for _, provider := range SecretProviders(globalConfig) {
+		lister, ok := provider.(secretLister)
+		if !ok {
+			continue
+		}
+
+		services, err := lister.List()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", provider.Name(), err.Error()))
+			continue
+		}
+
+		for _, service := range services {
+			listings = append(listings, SecretListing{Service: service, Store: provider.Name()})
+		}
+	}
+
+	sort.SliceStable(listings, func(i, j int) bool {
+		return listings[i].Service < listings[j].Service
+	})
+
+	if len(errs) > 0 {
+		return listings, fmt.Errorf("list secrets: %s", strings.Join(errs, "; "))
+	}
+
+	return listings, nil
+}
+
+/* -------------------- Secret lookups -------------------- */
+
+// SecretLookup is a module's attempt to load a secret with ModuleSecret
+type SecretLookup struct {
+	// Err is the error the secret providers returned, if any
+	Err error
+
+	// Module is the name of the module that looked the secret up
+	Module string
+
+	// Service is the service the secret was looked up by
+	Service string
+
+	// Source is where the secret came from: SecretSourceConfig, the name of the
+	// provider that had it, or "" if it wasn't found
+	Source string
+
+	// Target is the setting the secret is loaded into
+	Target *string
+}
+
+var secretLookups = struct {
+	sync.Mutex
+	lookups   []SecretLookup
+	offline   bool
+	recording bool
+}{}
+
+// RecordSecretLookups calls fn, usually to create module settings, and returns
+// the secrets that were looked up with ModuleSecret while it ran. This is how
+// the service name a module uses, its name or its baseURL, is worked out
+// without having to know how each module chooses it.
+func RecordSecretLookups(fn func()) (lookups []SecretLookup) {
+	secretLookups.Lock()
+	secretLookups.lookups = []SecretLookup{}
+	secretLookups.recording = true
+	secretLookups.Unlock()
+
+	// Recording stops even if fn panics, so that later lookups aren't kept
+	defer func() {
+		secretLookups.Lock()
+		defer secretLookups.Unlock()
+
+		lookups = secretLookups.lookups
+		secretLookups.lookups = nil
+		secretLookups.recording = false
+	}()
+
+	fn()
+
+	return lookups
+}
+
+// RecordSecretReferences calls fn, usually to create module settings, and returns the
+// secrets that were looked up with ModuleSecret while it ran, as RecordSecretLookups
+// does. The secret providers aren't asked for them, so no commands are run and nothing
+// is decrypted: only the secrets set in the config are found
+func RecordSecretReferences(fn func()) []SecretLookup {
+	secretLookups.Lock()
+	secretLookups.offline = true
+	secretLookups.Unlock()
+
+	defer func() {
+		secretLookups.Lock()
+		secretLookups.offline = false
+		secretLookups.Unlock()
+	}()
+
+	return RecordSecretLookups(fn)
+}
+
+func secretProvidersSkipped() bool {
+	secretLookups.Lock()
+	defer secretLookups.Unlock()
+
+	return secretLookups.offline
+}
+
+func recordSecretLookup(lookup SecretLookup) {
+	secretLookups.Lock()
+	defer secretLookups.Unlock()
+
+	if secretLookups.recording {
+		secretLookups.lookups = append(secretLookups.lookups, lookup)
+	}
+}
+
 type program struct {
 	store  string
 	runner client.ProgramFunc
@@ -0,0 +1,31 @@
+package cfg
+
+import (
+	"path/filepath"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/state"
+)
+
+const stateFile = "state.json"
+
+// ConfigureState sets up where the UI state, such as the selected items, the displayed
+// sources and dashboard and the focused widget, is remembered. It's kept in state.json in
+// the config directory unless it's turned off:
+//
+//	wtf:
+//	  restoreState: false
+func ConfigureState(config *config.Config) {
+	if !config.UBool("wtf.restoreState", true) {
+		state.Configure("")
+		return
+	}
+
+	configDir, err := WtfConfigDir()
+	if err != nil {
+		state.Configure("")
+		return
+	}
+
+	state.Configure(filepath.Join(configDir, stateFile))
+}
@@ -0,0 +1,136 @@
+package cfg
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	// themeConfigPath is where the config names its theme
+	themeConfigPath = "wtf.theme"
+
+	// themesDir is the directory of the config directory that users' own themes are in
+	themesDir = "themes"
+)
+
+//go:embed themes/*.yml
+var builtinThemes embed.FS
+
+/* -------------------- Exported Functions -------------------- */
+
+// BuiltinThemes returns the names of the themes that ship with WTF
+func BuiltinThemes() []string {
+	names := []string{}
+
+	entries, _ := fs.ReadDir(builtinThemes, themesDir)
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())))
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ApplyTheme fills in the colors in `wtf.colors` from the theme named in `wtf.theme`.
+// Colors set in the config win over the theme's, and module colors win over both. dir
+// is the directory relative theme paths are resolved against, usually the config file's
+func ApplyTheme(cfg *config.Config, dir string) error {
+	name := cfg.UString(themeConfigPath)
+	if name == "" {
+		return nil
+	}
+
+	theme, err := LoadTheme(name, dir)
+	if err != nil {
+		return err
+	}
+
+	colors := map[string]interface{}{}
+	mergeMaps(colors, theme)
+
+	if configured, err := cfg.Map("wtf.colors"); err == nil {
+		mergeMaps(colors, configured)
+	}
+
+	return cfg.Set("wtf.colors", colors)
+}
+
+// LoadTheme reads the colors a theme defines. A theme is either the path of a theme
+// file, the name of a theme file in the themes directory of the config directory, such
+// as ~/.config/wtf/themes/mine.yml, or the name of one of the built-in themes. A theme
+// file is laid out like `wtf.colors`, with the colors of the semantic roles in `roles`:
+//
+//	background: "#002b36"
+//	border:
+//	  focused: "#cb4b16"
+//	text: "#839496"
+//	roles:
+//	  ok: "#859900"
+//	  warn: "#b58900"
+//	  error: "#dc322f"
+func LoadTheme(name, dir string) (map[string]interface{}, error) {
+	path, err := ThemeFilePath(name, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if path != "" {
+		data, err = os.ReadFile(path)
+	} else {
+		data, err = builtinThemes.ReadFile(themesDir + "/" + name + ".yml")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("theme %s: %w", name, err)
+	}
+
+	parsed, err := config.ParseYamlBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("theme %s: %w", name, err)
+	}
+
+	theme, ok := parsed.Root.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("theme %s: expected a map of colors", name)
+	}
+
+	return theme, nil
+}
+
+// ThemeFilePath returns the path of the file a theme is read from, or "" if it's one of
+// the built-in themes. It returns an error if there's no such theme
+func ThemeFilePath(name, dir string) (string, error) {
+	if strings.ContainsAny(name, `/\`) || filepath.Ext(name) == ".yml" || filepath.Ext(name) == ".yaml" {
+		path, err := expandHomeDir(name)
+		if err != nil {
+			return "", err
+		}
+
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		return path, nil
+	}
+
+	if configDir, err := WtfConfigDir(); err == nil {
+		path := filepath.Join(configDir, themesDir, name+".yml")
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	if _, err := fs.Stat(builtinThemes, themesDir+"/"+name+".yml"); errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("unknown theme %q, expected a path or one of %s", name, strings.Join(BuiltinThemes(), ", "))
+	}
+
+	return "", nil
+}
@@ -0,0 +1,109 @@
+package cfg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BuiltinThemes(t *testing.T) {
+	themes := BuiltinThemes()
+
+	assert.Contains(t, themes, "dark")
+	assert.Contains(t, themes, "light")
+	assert.Contains(t, themes, "solarized-dark")
+}
+
+func Test_ApplyTheme(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	tests := []struct {
+		name        string
+		yaml        string
+		expectedErr bool
+		expected    map[string]string
+	}{
+		{
+			name:     "without a theme",
+			yaml:     "wtf:\n  colors:\n    text: white",
+			expected: map[string]string{"text": "white", "roles.ok": ""},
+		},
+		{
+			name: "with a built-in theme",
+			yaml: "wtf:\n  theme: solarized-dark",
+			expected: map[string]string{
+				"background":     "#002b36",
+				"roles.ok":       "#859900",
+				"border.focused": "#cb4b16",
+			},
+		},
+		{
+			name: "with colors that override the theme",
+			yaml: "wtf:\n  theme: solarized-dark\n  colors:\n    background: black\n    roles:\n      ok: lime",
+			expected: map[string]string{
+				"background": "black",
+				"roles.ok":   "lime",
+				"roles.warn": "#b58900",
+			},
+		},
+		{
+			name:        "with an unknown theme",
+			yaml:        "wtf:\n  theme: neon",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			err = ApplyTheme(conf, "")
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			for path, expected := range tt.expected {
+				assert.Equal(t, expected, conf.UString("wtf.colors."+path), path)
+			}
+		})
+	}
+}
+
+func Test_LoadTheme_File(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "mine.yml"), []byte("roles:\n  accent: purple\n"), 0600))
+
+	theme, err := LoadTheme("mine.yml", dir)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"roles": map[string]interface{}{"accent": "purple"}}, theme)
+
+	_, err = LoadTheme("missing.yml", dir)
+	assert.Error(t, err)
+}
+
+func Test_ThemeFilePath(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+
+	themesPath := filepath.Join(configDir, "wtf", "themes")
+	assert.NoError(t, os.MkdirAll(themesPath, 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(themesPath, "dark.yml"), []byte("text: white\n"), 0600))
+
+	path, err := ThemeFilePath("dark", "/etc/wtf")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(themesPath, "dark.yml"), path)
+
+	path, err = ThemeFilePath("light", "/etc/wtf")
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+
+	path, err = ThemeFilePath("themes/mine.yml", "/etc/wtf")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("/etc/wtf", "themes", "mine.yml"), path)
+}
@@ -1,5 +1,7 @@
 package cfg
 
+import "sort"
+
 // Validations represent a collection of config setting validations
 type Validations struct {
 	validations map[string]Validatable
@@ -14,6 +16,22 @@ func NewValidations() *Validations {
 	return vals
 }
 
+// All returns the validations, in order of the setting they validate
+func (vals *Validations) All() []Validatable {
+	keys := []string{}
+	for key := range vals.validations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	validatables := make([]Validatable, len(keys))
+	for idx, key := range keys {
+		validatables[idx] = vals.validations[key]
+	}
+
+	return validatables
+}
+
 func (vals *Validations) append(key string, posVal Validatable) {
 	vals.validations[key] = posVal
 }
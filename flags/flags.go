@@ -10,16 +10,33 @@ import (
 	"github.com/chzyer/readline"
 	goFlags "github.com/jessevdk/go-flags"
 	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/help"
+	"github.com/wtfutil/wtf/schema"
+	"github.com/wtfutil/wtf/secrets"
+	"github.com/wtfutil/wtf/snapshot"
+	"github.com/wtfutil/wtf/validate"
+)
+
+// The size of the terminal the snapshot command draws when none is given
+const (
+	defaultSnapshotHeight = 40
+	defaultSnapshotWidth  = 120
 )
 
 // Flags is the container for command line flag data
 type Flags struct {
-	Config  string `short:"c" long:"config" optional:"yes" description:"Path to config file"`
-	Module  string `short:"m" long:"module" optional:"yes" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
-	Profile bool   `short:"p" long:"profile" optional:"yes" description:"Profile application memory usage"`
-	Version bool   `short:"v" long:"version" description:"Show version info"`
+	Address  string `long:"address" description:"Address to serve widget data on in headless mode, i.e.: 'localhost:7007'"`
+	Config   string `short:"c" long:"config" description:"Path to config file"`
+	Env      string `short:"e" long:"env" description:"Environment overlay to apply to the config, i.e.: 'wtfutil --env=work'. Overrides WTF_ENV"`
+	Format   string `long:"format" description:"Format of the snapshot command's output: ansi, html, svg or txt. Defaults to the output file's extension, or txt"`
+	Headless bool   `long:"headless" optional:"yes" description:"Run without a terminal, serving widget data as JSON over HTTP"`
+	Module   string `short:"m" long:"module" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
+	Output   string `short:"o" long:"output" description:"File the snapshot command writes to. Defaults to standard output"`
+	Profile  bool   `short:"p" long:"profile" optional:"yes" description:"Profile application memory usage"`
+	Size     string `long:"size" description:"Size of the terminal the snapshot command draws, i.e.: '160x48'. Defaults to 120x40"`
+	Version  bool   `short:"v" long:"version" description:"Show version info"`
 	// Work-around go-flags misfeatures. If any sub-command is defined
 	// then `wtf` (no sub-commands, the common usage), is warned about.
 	Opt struct {
@@ -38,6 +55,43 @@ Commands:
   Requires wtf.secretStore to be configured.  See individual modules for
   information on what service and secret means for their configuration,
   not all modules use secrets.
+
+  delete-secret <service>
+    service      Service URL or module name of secret.
+  Delete a secret from the secret store. Requires wtf.secretStore to be
+  configured.
+
+  list-secrets
+  List the services the secret store, secrets file and pass have secrets
+  for. Secret values are never displayed.
+
+  check-secrets
+  Show the service each module in the config looks its secret up by,
+  which is either the module's name or its baseURL, and where the secret
+  was found. Exits with a non-zero status if an enabled module's secret
+  is missing.
+
+  serve [address]
+    address      Address to listen on. Defaults to wtf.headless.address,
+                 or localhost:7007.
+  Run without a terminal, refreshing widgets on their usual schedule and
+  serving their latest content as JSON at /api/widgets. Same as --headless.
+
+  snapshot [--format ansi|html|svg|txt] [-o file] [--size 120x40]
+  Refresh every widget once and write what the dashboard displays, drawn
+  on a terminal of the given size, as plain text, ANSI escape sequences,
+  an HTML page or an SVG image. For sharing dashboards, status pages and
+  golden-file tests of dashboards.
+
+  schema
+  Print a JSON Schema describing the config file, for editors that can use
+  one for autocompletion and validation, such as yaml-language-server.
+
+  validate [file]
+    file         Config file to check. Defaults to the current config file.
+  Check a config file for unknown keys, values of the wrong type, missing
+  required keys, invalid refresh intervals and overlapping modules, without
+  running any modules. Exits with a non-zero status if any errors are found.
 `
 
 // NewFlags creates an instance of Flags
@@ -53,6 +107,31 @@ func (flags *Flags) ConfigFilePath() string {
 	return flags.Config
 }
 
+// RenderOfflineIf runs the commands that don't need the config file to be loaded, and
+// exits once they're done. They run before it's loaded so that they can report on a broken
+// config file, and don't run the commands it refers to. Validating creates each module's
+// settings with the secret providers turned off, so no secrets are fetched either
+func (flags *Flags) RenderOfflineIf() {
+	switch flags.Opt.Cmd {
+	case "schema":
+		schema.Display()
+		os.Exit(0)
+	case "validate":
+		filePath := flags.ConfigFilePath()
+
+		if len(flags.Opt.Args) > 1 {
+			fmt.Fprintf(os.Stderr, "validate: too many arguments, see `%s --help`\n", os.Args[0])
+			os.Exit(1)
+		}
+
+		if len(flags.Opt.Args) == 1 {
+			filePath = flags.Opt.Args[0]
+		}
+
+		os.Exit(validate.Display(filePath))
+	}
+}
+
 // RenderIf displays special-case information based on the flags passed
 // in, if any flags were passed in
 func (flags *Flags) RenderIf(config *config.Config) {
@@ -81,6 +160,12 @@ func (flags *Flags) RenderIf(config *config.Config) {
 	}
 
 	switch cmd := flags.Opt.Cmd; cmd {
+	case "schema", "validate":
+		// Handled by RenderOfflineIf before the config file is loaded
+		return
+	case "serve", "snapshot":
+		// Handled by main once the app has been configured
+		return
 	case "save-secret":
 		var service, secret string
 		args := flags.Opt.Args
@@ -123,12 +208,44 @@ func (flags *Flags) RenderIf(config *config.Config) {
 
 		fmt.Printf("Saved secret for service %q\n", service)
 		os.Exit(0)
+	case "delete-secret":
+		args := flags.Opt.Args
+
+		if len(args) < 1 || args[0] == "" {
+			fmt.Fprintf(os.Stderr, "delete-secret: service required, see `%s --help`\n", os.Args[0])
+			os.Exit(1)
+		}
+
+		if len(args) > 1 {
+			fmt.Fprintf(os.Stderr, "delete-secret: too many arguments, see `%s --help`\n", os.Args[0])
+			os.Exit(1)
+		}
+
+		os.Exit(secrets.Delete(config, args[0]))
+	case "list-secrets":
+		os.Exit(secrets.DisplayList(config))
+	case "check-secrets":
+		os.Exit(secrets.DisplayCheck(config))
 	default:
 		fmt.Fprintf(os.Stderr, "Command `%s` is not supported, try `%s --help`\n", cmd, os.Args[0])
 		os.Exit(1)
 	}
 }
 
+// HeadlessAddress returns the address that headless mode should listen on, preferring
+// the command line over the configuration
+func (flags *Flags) HeadlessAddress(config *config.Config) string {
+	if flags.Opt.Cmd == "serve" && len(flags.Opt.Args) > 0 {
+		return flags.Opt.Args[0]
+	}
+
+	if flags.Address != "" {
+		return flags.Address
+	}
+
+	return config.UString("wtf.headless.address", app.DefaultHeadlessAddress)
+}
+
 // HasCustomConfig returns TRUE if a config path was passed in, FALSE if one was not
 func (flags *Flags) HasCustomConfig() bool {
 	return flags.hasCustom
@@ -139,11 +256,46 @@ func (flags *Flags) HasModule() bool {
 	return len(flags.Module) > 0
 }
 
+// IsHeadless returns TRUE if the app should run without a terminal, FALSE if it should not
+func (flags *Flags) IsHeadless() bool {
+	return flags.Headless || flags.Opt.Cmd == "serve"
+}
+
+// IsSnapshot returns TRUE if the app should write a snapshot of the dashboard instead of
+// displaying it, FALSE if it should not
+func (flags *Flags) IsSnapshot() bool {
+	return flags.Opt.Cmd == "snapshot"
+}
+
 // HasVersion returns TRUE if the version flag was passed in, FALSE if it was not
 func (flags *Flags) HasVersion() bool {
 	return flags.Version
 }
 
+// SnapshotFormat returns the format the snapshot command writes in, preferring the
+// format flag over the output file's extension
+func (flags *Flags) SnapshotFormat() (snapshot.Format, error) {
+	if flags.Format != "" {
+		return snapshot.ParseFormat(flags.Format)
+	}
+
+	return snapshot.FormatFor(flags.Output), nil
+}
+
+// SnapshotSize returns the width and height of the terminal the snapshot command draws
+func (flags *Flags) SnapshotSize() (int, int, error) {
+	if flags.Size == "" {
+		return defaultSnapshotWidth, defaultSnapshotHeight, nil
+	}
+
+	var width, height int
+	if _, err := fmt.Sscanf(strings.ToLower(flags.Size), "%dx%d", &width, &height); err != nil || width < 1 || height < 1 {
+		return 0, 0, fmt.Errorf("%q is not a size, use WIDTHxHEIGHT, i.e.: '160x48'", flags.Size)
+	}
+
+	return width, height, nil
+}
+
 // Parse parses the incoming flags
 func (flags *Flags) Parse() {
 	parser := goFlags.NewParser(flags, goFlags.Default)
@@ -154,6 +306,15 @@ func (flags *Flags) Parse() {
 		}
 	}
 
+	// The environment is read wherever a config file is loaded, including by dashboards
+	// and when the config is reloaded, and is passed on to the commands modules run
+	if flags.Env != "" {
+		if err := os.Setenv(cfg.EnvironmentVar, flags.Env); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// If we have a custom config, then we're done parsing parameters, we don't need to
 	// generate the default value
 	flags.hasCustom = (len(flags.Config) > 0)
@@ -0,0 +1,31 @@
+package flags
+
+import (
+	"testing"
+
+	goFlags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/snapshot"
+)
+
+func Test_ParseSnapshotArgs(t *testing.T) {
+	flags := &Flags{}
+
+	_, err := goFlags.NewParser(flags, goFlags.Default).ParseArgs([]string{"snapshot", "--format", "html", "-o", "x", "--size", "80x24"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, flags.IsSnapshot())
+	assert.Equal(t, "html", flags.Format)
+	assert.Equal(t, "x", flags.Output)
+
+	format, err := flags.SnapshotFormat()
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot.HTML, format)
+
+	width, height, err := flags.SnapshotSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 80, width)
+	assert.Equal(t, 24, height)
+}
@@ -0,0 +1,22 @@
+package {{(Lower .Name)}}
+
+import (
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// init registers the module with the app, making it available to config files as
+// `type: {{(Lower .Name)}}`
+func init() {
+	wtf.RegisterModule(wtf.ModuleDefinition{
+		DocPath: "{{(Lower .Name)}}",
+		NewSettings: func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+			return NewSettingsFromYAML(name, moduleConfig, globalConfig)
+		},
+		NewWidget: func(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings interface{}) wtf.Wtfable {
+			return NewWidget(tviewApp, redrawChan, pages, settings.(*Settings))
+		},
+		Type: "{{(Lower .Name)}}",
+	})
+}
@@ -18,7 +18,7 @@ import (
 
 const (
 	defaultWidgetName = "NewTextWidget"
-	widgetMaker       = "app/widget_maker.go"
+	moduleList        = "modules/all/all.go"
 )
 
 func main() {
@@ -35,9 +35,10 @@ func main() {
 
 	createModuleDirectory(data)
 
+	generateModuleFile(data)
 	generateWidgetFile(data)
 	generateSettingsFile(data)
-	fmt.Println("Don't forget to register your module in file", widgetMaker)
+	fmt.Println("Don't forget to add your module to the imports in file", moduleList)
 }
 
 /* -------------------- Unexported Functions -------------------- */
@@ -49,6 +50,20 @@ func createModuleDirectory(data struct{ Name string }) {
 	}
 }
 
+func generateModuleFile(data struct{ Name string }) {
+	tpl, _ := template.New("module.tpl").Funcs(template.FuncMap{
+		"Lower": strings.ToLower,
+	}).ParseFiles("generator/module.tpl")
+
+	out, err := os.Create(fmt.Sprintf("modules/%s/module.go", strings.ToLower(data.Name)))
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	defer out.Close()
+
+	tpl.Execute(out, data)
+}
+
 func generateWidgetFile(data struct{ Name string }) {
 	tpl, _ := template.New("textwidget.tpl").Funcs(template.FuncMap{
 		"Lower": strings.ToLower,
@@ -2,16 +2,19 @@ package help
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
 )
 
 // Display displays the output of the --help argument
 func Display(moduleName string, cfg *config.Config) {
 	if moduleName == "" {
 		fmt.Println("\n  --module takes a module name as an argument, i.e: '--module=github'")
+		fmt.Printf("\n  Available modules:\n\n%s\n", moduleList())
 	} else {
 		fmt.Printf("%s\n", helpFor(moduleName, cfg))
 	}
@@ -23,12 +26,12 @@ func helpFor(moduleName string, cfg *config.Config) string {
 		return ""
 	}
 
-	widget := app.MakeWidget(nil, nil, moduleName, cfg, nil)
+	widget := app.MakeWidget(nil, nil, moduleName, cfg, "", nil)
 
 	// Since we are forcing enabled config, if no module
 	// exists, we will get the unknown one
-	if widget.CommonSettings().Title == "Unknown" {
-		return "Unable to find module " + moduleName
+	if _, ok := wtf.LookupModule(widget.CommonSettings().Type); !ok {
+		return "Unable to find module " + moduleName + "\n\n  Available modules:\n\n" + moduleList()
 	}
 
 	result := ""
@@ -38,3 +41,19 @@ func helpFor(moduleName string, cfg *config.Config) string {
 	result += widget.ConfigText()
 	return result
 }
+
+// moduleList returns the types of all the registered modules, wrapped to fit the console
+func moduleList() string {
+	lines := []string{}
+	line := "   "
+
+	for _, moduleType := range wtf.ModuleTypes() {
+		if len(line)+len(moduleType) > 78 {
+			lines = append(lines, line)
+			line = "   "
+		}
+		line += " " + moduleType
+	}
+
+	return strings.Join(append(lines, line), "\n")
+}
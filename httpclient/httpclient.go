@@ -0,0 +1,302 @@
+// Package httpclient provides the HTTP clients modules make their requests with. The
+// clients share their connections and are set up from `wtf.http`, which configures the
+// proxy, the certificates, the timeout and the user agent for every module, and how
+// requests to particular hosts are rate limited and retried.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultTimeout is how long a request can take when no timeout is configured
+const DefaultTimeout = 30 * time.Second
+
+// Options are the settings every client follows
+type Options struct {
+	// CAFile is a PEM bundle of certificate authorities to trust besides the system's
+	CAFile string
+
+	// CertFile and KeyFile are the PEM certificate and key presented to servers that
+	// ask for a client certificate
+	CertFile string
+	KeyFile  string
+
+	// Proxy is the URL of the proxy requests go through. Without one, the HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables are used
+	Proxy string
+
+	Timeout   time.Duration
+	UserAgent string
+
+	// Hosts are the rate limits and retries of particular hosts. The first one that
+	// matches a request's host applies
+	Hosts []HostOptions
+}
+
+// HostOptions limits and retries the requests made to the hosts that match Pattern
+type HostOptions struct {
+	// Pattern is a host name, which can contain the wildcards path.Match understands,
+	// such as "*.atlassian.net"
+	Pattern string
+
+	// RateLimit is the most requests a second that are made to the hosts, with Burst
+	// requests allowed at once. Zero doesn't limit them
+	Burst     int
+	RateLimit float64
+
+	// Retries is how many times requests that fail with a network error or a 429, 502,
+	// 503 or 504 response are tried again. RetryWait is the delay before the first
+	// retry, which doubles after each one unless the server asks for a longer one
+	Retries   int
+	RetryWait time.Duration
+}
+
+// settings are what the clients follow, built from the Options
+type settings struct {
+	hosts             []*host
+	http1Transport    *http.Transport
+	secureTransport   *http.Transport
+	insecureTransport *http.Transport
+	timeout           time.Duration
+	userAgent         string
+}
+
+// host is the state shared by the requests to the hosts one of the HostOptions matches
+type host struct {
+	HostOptions
+	limiter *rate.Limiter
+}
+
+var (
+	clientMutex = &sync.RWMutex{}
+
+	hosts     = []*host{}
+	timeout   = DefaultTimeout
+	userAgent = ""
+
+	http1Transport    = withoutHTTP2(newTransport(nil, nil))
+	secureTransport   = newTransport(nil, nil)
+	insecureTransport = newTransport(nil, &tls.Config{InsecureSkipVerify: true})
+
+	// The round trippers the clients share, which always use the current settings
+	http1RoundTripper    = &roundTripper{transport: func() *http.Transport { return http1Transport }}
+	secureRoundTripper   = &roundTripper{transport: func() *http.Transport { return secureTransport }}
+	insecureRoundTripper = &roundTripper{transport: func() *http.Transport { return insecureTransport }}
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// Client returns an HTTP client set up from `wtf.http`. Clients keep following the
+// settings when they're reconfigured, except for the timeout. Modules can change the
+// timeout of the clients they're given
+func Client() *http.Client {
+	clientMutex.RLock()
+	defer clientMutex.RUnlock()
+
+	return &http.Client{Transport: secureRoundTripper, Timeout: timeout}
+}
+
+// Check returns an error describing the settings in opts that are invalid, which
+// Configure would leave out, or nil if they're all valid
+func Check(opts Options) error {
+	_, err := newSettings(opts)
+	return err
+}
+
+// Configure replaces the settings clients follow. The settings that are invalid, such as
+// a certificate file that can't be read, are left out and reported in the error
+func Configure(opts Options) error {
+	newSet, err := newSettings(opts)
+
+	clientMutex.Lock()
+
+	http1Transport.CloseIdleConnections()
+	secureTransport.CloseIdleConnections()
+	insecureTransport.CloseIdleConnections()
+
+	hosts = newSet.hosts
+	http1Transport = newSet.http1Transport
+	secureTransport = newSet.secureTransport
+	insecureTransport = newSet.insecureTransport
+	timeout = newSet.timeout
+	userAgent = newSet.userAgent
+
+	clientMutex.Unlock()
+
+	return err
+}
+
+// HTTP1Client returns a client like Client's that only speaks HTTP/1.1, for servers that
+// misbehave when HTTP/2 is negotiated
+func HTTP1Client() *http.Client {
+	client := Client()
+	client.Transport = http1RoundTripper
+
+	return client
+}
+
+// NewClient returns a client like Client's, which doesn't verify the certificates of the
+// servers it connects to unless verifyServerCertificate is set. It's for modules with a
+// verifyServerCertificate setting
+func NewClient(verifyServerCertificate bool) *http.Client {
+	client := Client()
+	if !verifyServerCertificate {
+		client.Transport = insecureRoundTripper
+	}
+
+	return client
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newSettings builds the settings clients follow from the options, leaving out the ones
+// that are invalid and describing them in the error
+func newSettings(opts Options) (settings, error) {
+	problems := []string{}
+
+	proxy := http.ProxyFromEnvironment
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err == nil && proxyURL.Host == "" {
+			err = errors.New("it has no host")
+		}
+
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("proxy %q is invalid: %s", opts.Proxy, err))
+		} else {
+			proxy = http.ProxyURL(proxyURL)
+		}
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.CAFile != "" {
+		pool, err := certPool(opts.CAFile)
+		if err != nil {
+			problems = append(problems, err.Error())
+		} else {
+			tlsConfig.RootCAs = pool
+		}
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("the client certificate can't be loaded: %s", err))
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	insecureConfig := tlsConfig.Clone()
+	insecureConfig.InsecureSkipVerify = true
+
+	newSet := settings{
+		hosts:             []*host{},
+		http1Transport:    withoutHTTP2(newTransport(proxy, tlsConfig.Clone())),
+		secureTransport:   newTransport(proxy, tlsConfig),
+		insecureTransport: newTransport(proxy, insecureConfig),
+		timeout:           opts.Timeout,
+		userAgent:         opts.UserAgent,
+	}
+
+	if newSet.timeout <= 0 {
+		newSet.timeout = DefaultTimeout
+	}
+
+	for _, hostOpts := range opts.Hosts {
+		if _, err := path.Match(hostOpts.Pattern, ""); err != nil || hostOpts.Pattern == "" {
+			problems = append(problems, fmt.Sprintf("host %q is an invalid pattern", hostOpts.Pattern))
+			continue
+		}
+
+		newSet.hosts = append(newSet.hosts, newHost(hostOpts))
+	}
+
+	if len(problems) > 0 {
+		return newSet, errors.New(strings.Join(problems, ", "))
+	}
+
+	return newSet, nil
+}
+
+// certPool returns the system's certificate authorities along with those in the file
+func certPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("the CA file can't be read: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("the CA file %s has no PEM certificates", caFile)
+	}
+
+	return pool, nil
+}
+
+// hostFor returns the first of the hosts that matches the host name, or nil
+func hostFor(name string) *host {
+	for _, candidate := range hosts {
+		if matched, _ := path.Match(candidate.Pattern, name); matched {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
+func newHost(opts HostOptions) *host {
+	result := &host{HostOptions: opts}
+
+	if opts.RateLimit > 0 {
+		burst := opts.Burst
+		if burst < 1 {
+			burst = 1
+		}
+
+		result.limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), burst)
+	}
+
+	return result
+}
+
+// newTransport returns a transport like http.DefaultTransport with the proxy and TLS
+// settings. A nil proxy uses the environment's
+func newTransport(proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxy != nil {
+		transport.Proxy = proxy
+	}
+
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport
+}
+
+// withoutHTTP2 stops the transport from negotiating HTTP/2
+func withoutHTTP2(transport *http.Transport) *http.Transport {
+	transport.ForceAttemptHTTP2 = false
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+
+	return transport
+}
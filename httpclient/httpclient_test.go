@@ -0,0 +1,155 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// configure applies the options for the length of a test
+func configure(t *testing.T, opts Options) {
+	assert.NoError(t, Configure(opts))
+	t.Cleanup(func() { _ = Configure(Options{}) })
+}
+
+// get makes a GET request with the client and returns the response's status code
+func get(t *testing.T, client *http.Client, url string) int {
+	resp, err := client.Get(url)
+	if !assert.NoError(t, err) {
+		return 0
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return resp.StatusCode
+}
+
+func Test_UserAgent(t *testing.T) {
+	agents := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents <- r.UserAgent()
+	}))
+	defer server.Close()
+
+	configure(t, Options{UserAgent: "wtf-test"})
+
+	get(t, Client(), server.URL)
+	assert.Equal(t, "wtf-test", <-agents)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	req.Header.Set("User-Agent", "module")
+	resp, err := Client().Do(req)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, "module", <-agents)
+}
+
+func Test_Retries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	configure(t, Options{
+		Hosts: []HostOptions{{Pattern: "127.0.0.*", Retries: 2, RetryWait: time.Millisecond}},
+	})
+
+	t.Run("a GET", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, get(t, Client(), server.URL))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("a POST", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+
+		resp, err := Client().Post(server.URL, "text/plain", strings.NewReader("data"))
+		assert.NoError(t, err)
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+}
+
+func Test_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	configure(t, Options{
+		Hosts: []HostOptions{{Pattern: "127.0.0.1", RateLimit: 20}},
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		get(t, Client(), server.URL)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func Test_CAFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := Client().Get(server.URL)
+	assert.Error(t, err)
+
+	assert.Equal(t, http.StatusOK, get(t, NewClient(false), server.URL))
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+
+	configure(t, Options{CAFile: caFile})
+
+	assert.Equal(t, http.StatusOK, get(t, Client(), server.URL))
+}
+
+func Test_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        Options
+		expectedErr string
+	}{
+		{
+			name: "with valid options",
+			opts: Options{Proxy: "http://proxy.corp:3128", Hosts: []HostOptions{{Pattern: "*.atlassian.net"}}},
+		},
+		{
+			name:        "with a proxy without a host",
+			opts:        Options{Proxy: "/proxy"},
+			expectedErr: `proxy "/proxy" is invalid: it has no host`,
+		},
+		{
+			name:        "with a missing CA file",
+			opts:        Options{CAFile: "/nonexistent/ca.pem"},
+			expectedErr: "the CA file can't be read: open /nonexistent/ca.pem: no such file or directory",
+		},
+		{
+			name:        "with a bad host pattern",
+			opts:        Options{Hosts: []HostOptions{{Pattern: "[api"}}},
+			expectedErr: `host "[api" is an invalid pattern`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.opts)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
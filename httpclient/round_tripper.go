@@ -0,0 +1,141 @@
+package httpclient
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryWait = time.Second
+
+	// maxRetryWait caps how long a server can ask for a retry to be put off
+	maxRetryWait = time.Minute
+)
+
+// roundTripper makes requests with the current settings, rate limiting and retrying them
+// as the settings of their host say
+type roundTripper struct {
+	// transport returns the transport to make the requests with, while the settings
+	// are locked
+	transport func() *http.Transport
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	clientMutex.RLock()
+	transport := rt.transport()
+	agent := userAgent
+	target := hostFor(req.URL.Hostname())
+	clientMutex.RUnlock()
+
+	if agent != "" && req.Header.Get("User-Agent") == "" {
+		// A round tripper mustn't change the request it's given
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", agent)
+	}
+
+	if target == nil {
+		return transport.RoundTrip(req)
+	}
+
+	wait := target.RetryWait
+	if wait <= 0 {
+		wait = defaultRetryWait
+	}
+
+	for attempt := 0; ; attempt++ {
+		if target.limiter != nil {
+			if err := target.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := transport.RoundTrip(req)
+
+		if attempt >= target.Retries || !canRetry(req) || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := wait
+		if resp != nil {
+			if after := retryAfter(resp); after > delay {
+				delay = after
+			}
+
+			// The connection can only be reused once the body's been read
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait *= 2
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// canRetry returns TRUE if the request can be made again without doing something twice
+func canRetry(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryAfter returns how long the response's Retry-After header asks to wait, up to
+// maxRetryWait, or zero if it doesn't have one
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(value); err == nil {
+		delay = time.Until(at)
+	}
+
+	if delay > maxRetryWait {
+		return maxRetryWait
+	}
+
+	return delay
+}
+
+// shouldRetry returns TRUE if the request failed in a way that trying again might fix
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"strings"
+	"time"
+)
+
+// Entry is a single line of the log file, broken into its parts
+type Entry struct {
+	Level   Level
+	Message string
+	Module  string
+	Time    time.Time
+}
+
+// ParseEntry breaks a line of the log file into its parts. It returns FALSE if the
+// line isn't a log entry
+func ParseEntry(line string) (Entry, bool) {
+	entry := Entry{}
+
+	if len(line) < len(timeFormat)+2 {
+		return entry, false
+	}
+
+	at, err := time.ParseInLocation(timeFormat, line[:len(timeFormat)], time.Local)
+	if err != nil {
+		return entry, false
+	}
+	entry.Time = at
+
+	rest := strings.TrimPrefix(line[len(timeFormat):], " ")
+
+	levelName, rest, _ := strings.Cut(rest, " ")
+	level, err := ParseLevel(levelName)
+	if err != nil {
+		return entry, false
+	}
+	entry.Level = level
+
+	if strings.HasPrefix(rest, "[") {
+		if end := strings.Index(rest, "] "); end > 0 {
+			entry.Module = rest[1:end]
+			rest = rest[end+2:]
+		}
+	}
+
+	entry.Message = rest
+
+	return entry, true
+}
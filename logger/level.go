@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level is the severity of a log entry
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "DEBUG",
+	LevelInfo:  "INFO",
+	LevelWarn:  "WARN",
+	LevelError: "ERROR",
+}
+
+// ParseLevel returns the level with the given name. Names are case-insensitive and
+// "warning" is accepted as an alias for "warn"
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+
+	return LevelInfo, fmt.Errorf("unknown log level: %q", name)
+}
+
+// String returns the name of the level as it's written to the log file
+func (level Level) String() string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("LEVEL(%d)", int(level))
+}
@@ -1,33 +1,122 @@
 package logger
 
 import (
-	"log"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxBackups is the number of rotated log files kept if none is configured
+	DefaultMaxBackups = 3
+
+	// DefaultMaxSize is the size in bytes the log file can reach before it's rotated
+	DefaultMaxSize int64 = 5 * 1024 * 1024
+
+	timeFormat = "2006-01-02 15:04:05"
+)
+
+// Options configures where and what the logger writes
+type Options struct {
+	Level      Level
+	MaxBackups int
+	MaxSize    int64
+	Path       string
+}
+
+var (
+	logFile  *os.File
+	logMutex = &sync.Mutex{}
+	logSize  int64
+	options  = Options{
+		Level:      LevelInfo,
+		MaxBackups: DefaultMaxBackups,
+		MaxSize:    DefaultMaxSize,
+	}
 )
 
 /* -------------------- Exported Functions -------------------- */
 
-func Log(msg string) {
-	if LogFileMissing() {
-		return
+// Configure sets where the log file lives, the minimum level that's written to it and
+// how it's rotated. An empty path uses the default log file location
+func Configure(opts Options) {
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	if opts.MaxBackups < 0 {
+		opts.MaxBackups = 0
 	}
 
-	f, err := os.OpenFile(LogFilePath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
-	if err != nil {
-		log.Fatalf("error opening file: %v", err)
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+
+	if opts.Path != options.Path {
+		closeFile()
 	}
-	defer func() { _ = f.Close() }()
 
-	log.SetOutput(f)
-	log.Println(msg)
+	options = opts
+}
+
+// Log writes an informational message that isn't associated with any module.
+// It's kept for compatibility, new code should use one of the leveled functions
+func Log(msg string) {
+	write(LevelInfo, "", msg)
+}
+
+// Debug writes a message that's only useful when tracking down a problem
+func Debug(msg string) {
+	write(LevelDebug, "", msg)
 }
 
+// Info writes an informational message
+func Info(msg string) {
+	write(LevelInfo, "", msg)
+}
+
+// Warn writes a message about something that's wrong but recoverable
+func Warn(msg string) {
+	write(LevelWarn, "", msg)
+}
+
+// Error writes a message about a failure
+func Error(msg string) {
+	write(LevelError, "", msg)
+}
+
+// LogFileMissing returns TRUE if there's nowhere to write the log to
 func LogFileMissing() bool {
 	return LogFilePath() == ""
 }
 
+// LogFilePath returns the path to the log file. Unless configured otherwise the log
+// lives in the wtf config directory, honouring XDG_CONFIG_HOME
 func LogFilePath() string {
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	return logFilePath()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func closeFile() {
+	if logFile != nil {
+		_ = logFile.Close()
+	}
+
+	logFile = nil
+	logSize = 0
+}
+
+func defaultLogFilePath() string {
+	if configDir := os.Getenv("XDG_CONFIG_HOME"); configDir != "" {
+		return filepath.Join(configDir, "wtf", "log.txt")
+	}
+
 	dir, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -35,3 +124,99 @@ func LogFilePath() string {
 
 	return filepath.Join(dir, ".config", "wtf", "log.txt")
 }
+
+// formatLine returns a log entry in the form written to the log file:
+//
+//	2006-01-02 15:04:05 INFO [module] message
+func formatLine(at time.Time, level Level, module, msg string) string {
+	line := at.Format(timeFormat) + " " + level.String()
+
+	if module != "" {
+		line += " [" + module + "]"
+	}
+
+	// Every entry is a single line so that the log can be read back line by line
+	msg = strings.ReplaceAll(strings.TrimRight(msg, "\n"), "\n", " ")
+
+	return line + " " + msg + "\n"
+}
+
+func logFilePath() string {
+	if options.Path != "" {
+		return options.Path
+	}
+
+	return defaultLogFilePath()
+}
+
+func openFile() error {
+	path := logFilePath()
+	if path == "" {
+		return fmt.Errorf("no log file path")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	logFile = file
+	logSize = stat.Size()
+
+	return nil
+}
+
+// rotate moves the current log file aside, shifting the existing backups along and
+// dropping the oldest one
+func rotate() {
+	path := logFilePath()
+
+	closeFile()
+
+	if options.MaxBackups == 0 {
+		_ = os.Remove(path)
+		return
+	}
+
+	for i := options.MaxBackups - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+	}
+
+	_ = os.Rename(path, path+".1")
+}
+
+// write appends an entry to the log file. Logging must never take the app down, so if
+// the log file can't be written to the entry is dropped
+func write(level Level, module, msg string) {
+	logMutex.Lock()
+	defer logMutex.Unlock()
+
+	if level < options.Level {
+		return
+	}
+
+	line := formatLine(time.Now(), level, module, msg)
+
+	if logFile != nil && logSize > 0 && logSize+int64(len(line)) > options.MaxSize {
+		rotate()
+	}
+
+	if logFile == nil {
+		if err := openFile(); err != nil {
+			return
+		}
+	}
+
+	written, _ := logFile.WriteString(line)
+	logSize += int64(written)
+}
@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readLines(t *testing.T, path string) []string {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func Test_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "log.txt")
+	Configure(Options{Level: LevelInfo, Path: path})
+	defer Configure(Options{Level: LevelInfo})
+
+	Debug("hidden")
+	Info("started")
+	Module("jira").Errorf("request failed: %d", 500)
+
+	lines := readLines(t, path)
+	assert.Equal(t, 2, len(lines))
+
+	entry, ok := ParseEntry(lines[1])
+	assert.True(t, ok)
+	assert.Equal(t, LevelError, entry.Level)
+	assert.Equal(t, "jira", entry.Module)
+	assert.Equal(t, "request failed: 500", entry.Message)
+}
+
+func Test_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.txt")
+	Configure(Options{Level: LevelDebug, MaxBackups: 2, MaxSize: 64, Path: path})
+	defer Configure(Options{Level: LevelInfo})
+
+	for i := 0; i < 4; i++ {
+		Info("a message that fills most of the log file")
+	}
+
+	assert.Equal(t, 1, len(readLines(t, path)))
+	assert.FileExists(t, path+".1")
+	assert.FileExists(t, path+".2")
+	assert.NoFileExists(t, path+".3")
+}
+
+func Test_ParseEntry(t *testing.T) {
+	at := time.Date(2022, 3, 4, 5, 6, 7, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		line     string
+		expected Entry
+		ok       bool
+	}{
+		{
+			name:     "without module",
+			line:     formatLine(at, LevelWarn, "", "disk nearly full"),
+			expected: Entry{Level: LevelWarn, Message: "disk nearly full", Time: at},
+			ok:       true,
+		},
+		{
+			name:     "with module",
+			line:     formatLine(at, LevelDebug, "github", "multi\nline"),
+			expected: Entry{Level: LevelDebug, Message: "multi line", Module: "github", Time: at},
+			ok:       true,
+		},
+		{
+			name: "partial line",
+			line: "5:06:07 INFO cut off",
+			ok:   false,
+		},
+		{
+			name: "unknown level",
+			line: "2022-03-04 05:06:07 LOUD hello",
+			ok:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := ParseEntry(strings.TrimSuffix(tt.line, "\n"))
+
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.expected, entry)
+			}
+		})
+	}
+}
+
+func Test_ParseLevel(t *testing.T) {
+	level, err := ParseLevel("Warning")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelWarn, level)
+
+	level, err = ParseLevel("verbose")
+	assert.Error(t, err)
+	assert.Equal(t, LevelInfo, level)
+}
@@ -0,0 +1,60 @@
+package logger
+
+import "fmt"
+
+// Logger writes log entries tagged with the name of the module that wrote them
+type Logger struct {
+	module string
+}
+
+// Module returns a logger whose entries are tagged with the given module name
+func Module(name string) *Logger {
+	return &Logger{module: name}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Debug writes a message that's only useful when tracking down a problem
+func (logger *Logger) Debug(msg string) {
+	write(LevelDebug, logger.module, msg)
+}
+
+// Debugf formats and writes a debug message
+func (logger *Logger) Debugf(format string, args ...interface{}) {
+	logger.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info writes an informational message
+func (logger *Logger) Info(msg string) {
+	write(LevelInfo, logger.module, msg)
+}
+
+// Infof formats and writes an informational message
+func (logger *Logger) Infof(format string, args ...interface{}) {
+	logger.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn writes a message about something that's wrong but recoverable
+func (logger *Logger) Warn(msg string) {
+	write(LevelWarn, logger.module, msg)
+}
+
+// Warnf formats and writes a warning message
+func (logger *Logger) Warnf(format string, args ...interface{}) {
+	logger.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error writes a message about a failure
+func (logger *Logger) Error(msg string) {
+	write(LevelError, logger.module, msg)
+}
+
+// Errorf formats and writes an error message
+func (logger *Logger) Errorf(format string, args ...interface{}) {
+	logger.Error(fmt.Sprintf(format, args...))
+}
+
+// Module returns the name of the module the logger's entries are tagged with
+func (logger *Logger) Module() string {
+	return logger.module
+}
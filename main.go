@@ -12,11 +12,14 @@ import (
 	_ "time/tzdata"
 
 	"github.com/logrusorgru/aurora/v4"
+	"github.com/olebedev/config"
 	"github.com/pkg/profile"
 
 	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/flags"
+	_ "github.com/wtfutil/wtf/modules/all"
+	"github.com/wtfutil/wtf/snapshot"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/wtf"
 )
@@ -30,14 +33,25 @@ func main() {
 	flags := flags.NewFlags()
 	flags.Parse()
 
+	// Commands that check the config file run before it's loaded
+	flags.RenderOfflineIf()
+
 	// Load the configuration file
 	cfg.Initialize(flags.HasCustomConfig())
 	config := cfg.LoadWtfConfigFile(flags.ConfigFilePath())
+	cfg.ConfigureLogger(config)
 
-	wtf.SetTerminal(config)
-
+	// Commands that manage secrets and describe modules work with the config as written
 	flags.RenderIf(config)
 
+	// Everything from here on builds widgets, which need the values the config refers to
+	cfg.InterpolateConfig(config)
+	cfg.ConfigureAlerts(config)
+	cfg.ConfigureHTTP(config)
+	cfg.ConfigureState(config)
+
+	wtf.SetTerminal(config)
+
 	if flags.Profile {
 		defer profile.Start(profile.MemProfile).Stop()
 	}
@@ -46,19 +60,109 @@ func main() {
 	openURLUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
 	utils.Init(openFileUtil, openURLUtil)
 
+	dashboards := cfg.Dashboards(config, flags.ConfigFilePath())
+	if len(dashboards) == 0 {
+		// Let the app report that there's nothing to display
+		dashboards = append(dashboards, cfg.Dashboard{Name: "wtf", ConfigFilePath: flags.ConfigFilePath()})
+	}
+
+	if flags.IsSnapshot() {
+		takeSnapshot(flags, config, dashboards[0])
+		return
+	}
+
+	if flags.IsHeadless() {
+		serveHeadless(flags, config, dashboards)
+		return
+	}
+
 	/* Initialize the App Manager */
-	appMan := app.NewAppManager()
-	appMan.MakeNewWtfApp(config, flags.Config)
+	appMan := app.NewAppManager(flags.ConfigFilePath())
 
-	currentApp, err := appMan.Current()
+	for _, dashboard := range dashboards {
+		appMan.MakeNewWtfApp(dashboard.Name, dashboardConfig(config, flags, dashboard), dashboard.ConfigFilePath)
+	}
+
+	err := appMan.Execute()
 	if err != nil {
 		fmt.Printf("\n%s %v\n", aurora.Red("ERROR"), err)
 		os.Exit(1)
 	}
+}
+
+// dashboardConfig returns the configuration for a dashboard, loading it from disk
+// unless it's the main configuration that's already been loaded
+func dashboardConfig(config *config.Config, flags *flags.Flags, dashboard cfg.Dashboard) *config.Config {
+	if dashboard.ConfigFilePath == flags.ConfigFilePath() {
+		return config
+	}
+
+	loaded := cfg.LoadWtfConfigFile(dashboard.ConfigFilePath)
+	cfg.InterpolateConfig(loaded)
+
+	return loaded
+}
+
+// serveHeadless runs every dashboard's widgets without a terminal and serves their
+// data over HTTP until the server fails
+func serveHeadless(flags *flags.Flags, config *config.Config, dashboards []cfg.Dashboard) {
+	headlessApp := app.NewHeadlessApp()
+
+	for _, dashboard := range dashboards {
+		headlessApp.AddDashboard(dashboard.Name, dashboardConfig(config, flags, dashboard), dashboard.ConfigFilePath)
+	}
+
+	address := flags.HeadlessAddress(config)
+	fmt.Printf("Serving widget data on http://%s/api/widgets\n", address)
+
+	err := headlessApp.Serve(address)
+	headlessApp.Stop()
 
-	err = currentApp.Execute()
 	if err != nil {
 		fmt.Printf("\n%s %v\n", aurora.Red("ERROR"), err)
 		os.Exit(1)
 	}
 }
+
+// takeSnapshot refreshes the dashboard's widgets once, draws them off-screen and writes
+// the result where the snapshot command's flags say
+func takeSnapshot(flags *flags.Flags, config *config.Config, dashboard cfg.Dashboard) {
+	err := writeSnapshot(flags, dashboardConfig(config, flags, dashboard), dashboard.ConfigFilePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+}
+
+func writeSnapshot(flags *flags.Flags, config *config.Config, configFilePath string) error {
+	format, err := flags.SnapshotFormat()
+	if err != nil {
+		return err
+	}
+
+	width, height, err := flags.SnapshotSize()
+	if err != nil {
+		return err
+	}
+
+	screen, err := app.RenderSnapshot(config, configFilePath, width, height, app.DefaultSnapshotTimeout)
+	if err != nil {
+		return err
+	}
+
+	if flags.Output == "" {
+		return snapshot.Write(os.Stdout, screen, format)
+	}
+
+	file, err := os.Create(flags.Output)
+	if err != nil {
+		return err
+	}
+
+	if err := snapshot.Write(file, screen, format); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -19,7 +20,7 @@ func project(projectID int, authToken string) (*Project, error) {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -46,7 +47,7 @@ func groups(projectID int, authToken string) ([]Group, error) {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -74,7 +75,7 @@ func resolveGroup(projectID int64, groupID, authToken string) error {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -96,7 +97,7 @@ func muteGroup(projectID int64, groupID, authToken string) error {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -118,7 +119,7 @@ func unmuteGroup(projectID int64, groupID, authToken string) error {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -139,6 +139,7 @@ func (widget *Widget) Refresh() {
 	groups, err := groups(
 		widget.settings.projectID,
 		widget.settings.authToken)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.groups = nil
@@ -200,10 +201,10 @@ func (widget *Widget) content() (string, string, bool) {
 				buf.WriteString("  ")
 			}
 			buf.WriteString(" " + g.Title())
-			row = fmt.Sprintf("[%s]%2d. %s[white]", rowColor, idx+1, buf.String())
+			row = fmt.Sprintf("[%s]%2d. %s[-]", rowColor, idx+1, buf.String())
 		} else {
 			row = fmt.Sprintf(
-				"[%s]%2d. %-31s %-11s  %-10s  count: %-9d  comments: %-2d[white]",
+				"[%s]%2d. %-31s %-11s  %-10s  count: %-9d  comments: %-2d[-]",
 				rowColor, idx+1, utils.Truncate(g.Type(), 30, true),
 				g.Context.Environment, g.Context.Severity,
 				g.NoticeCount, g.CommentCount)
@@ -0,0 +1,92 @@
+//go:build !custom_modules
+
+// The modules included in a standard build, see doc.go for building with fewer of them
+package all
+
+import (
+	_ "github.com/wtfutil/wtf/modules/airbrake"
+	_ "github.com/wtfutil/wtf/modules/asana"
+	_ "github.com/wtfutil/wtf/modules/azuredevops"
+	_ "github.com/wtfutil/wtf/modules/bamboohr"
+	_ "github.com/wtfutil/wtf/modules/bargraph"
+	_ "github.com/wtfutil/wtf/modules/buildkite"
+	_ "github.com/wtfutil/wtf/modules/cds/favorites"
+	_ "github.com/wtfutil/wtf/modules/cds/queue"
+	_ "github.com/wtfutil/wtf/modules/cds/status"
+	_ "github.com/wtfutil/wtf/modules/circleci"
+	_ "github.com/wtfutil/wtf/modules/clocks"
+	_ "github.com/wtfutil/wtf/modules/cmdrunner"
+	_ "github.com/wtfutil/wtf/modules/covid"
+	_ "github.com/wtfutil/wtf/modules/cryptocurrency/bittrex"
+	_ "github.com/wtfutil/wtf/modules/cryptocurrency/blockfolio"
+	_ "github.com/wtfutil/wtf/modules/cryptocurrency/cryptolive"
+	_ "github.com/wtfutil/wtf/modules/cryptocurrency/mempool"
+	_ "github.com/wtfutil/wtf/modules/datadog"
+	_ "github.com/wtfutil/wtf/modules/devto"
+	_ "github.com/wtfutil/wtf/modules/digitalclock"
+	_ "github.com/wtfutil/wtf/modules/digitalocean"
+	_ "github.com/wtfutil/wtf/modules/docker"
+	_ "github.com/wtfutil/wtf/modules/feedreader"
+	_ "github.com/wtfutil/wtf/modules/football"
+	_ "github.com/wtfutil/wtf/modules/gcal"
+	_ "github.com/wtfutil/wtf/modules/gerrit"
+	_ "github.com/wtfutil/wtf/modules/git"
+	_ "github.com/wtfutil/wtf/modules/github"
+	_ "github.com/wtfutil/wtf/modules/gitlab"
+	_ "github.com/wtfutil/wtf/modules/gitlabtodo"
+	_ "github.com/wtfutil/wtf/modules/gitter"
+	_ "github.com/wtfutil/wtf/modules/googleanalytics"
+	_ "github.com/wtfutil/wtf/modules/grafana"
+	_ "github.com/wtfutil/wtf/modules/group"
+	_ "github.com/wtfutil/wtf/modules/gspreadsheets"
+	_ "github.com/wtfutil/wtf/modules/hackernews"
+	_ "github.com/wtfutil/wtf/modules/healthchecks"
+	_ "github.com/wtfutil/wtf/modules/hibp"
+	_ "github.com/wtfutil/wtf/modules/ipaddresses/ipapi"
+	_ "github.com/wtfutil/wtf/modules/ipaddresses/ipinfo"
+	_ "github.com/wtfutil/wtf/modules/jenkins"
+	_ "github.com/wtfutil/wtf/modules/jira"
+	_ "github.com/wtfutil/wtf/modules/krisinformation"
+	_ "github.com/wtfutil/wtf/modules/kubernetes"
+	_ "github.com/wtfutil/wtf/modules/logger"
+	_ "github.com/wtfutil/wtf/modules/lunarphase"
+	_ "github.com/wtfutil/wtf/modules/mercurial"
+	_ "github.com/wtfutil/wtf/modules/nbascore"
+	_ "github.com/wtfutil/wtf/modules/newrelic"
+	_ "github.com/wtfutil/wtf/modules/nextbus"
+	_ "github.com/wtfutil/wtf/modules/opsgenie"
+	_ "github.com/wtfutil/wtf/modules/pagerduty"
+	_ "github.com/wtfutil/wtf/modules/pihole"
+	_ "github.com/wtfutil/wtf/modules/pivotal"
+	_ "github.com/wtfutil/wtf/modules/plugin"
+	_ "github.com/wtfutil/wtf/modules/pocket"
+	_ "github.com/wtfutil/wtf/modules/power"
+	_ "github.com/wtfutil/wtf/modules/progress"
+	_ "github.com/wtfutil/wtf/modules/resourceusage"
+	_ "github.com/wtfutil/wtf/modules/rollbar"
+	_ "github.com/wtfutil/wtf/modules/security"
+	_ "github.com/wtfutil/wtf/modules/spacex"
+	_ "github.com/wtfutil/wtf/modules/spotify"
+	_ "github.com/wtfutil/wtf/modules/spotifyweb"
+	_ "github.com/wtfutil/wtf/modules/status"
+	_ "github.com/wtfutil/wtf/modules/steam"
+	_ "github.com/wtfutil/wtf/modules/stocks/finnhub"
+	_ "github.com/wtfutil/wtf/modules/stocks/yfinance"
+	_ "github.com/wtfutil/wtf/modules/subreddit"
+	_ "github.com/wtfutil/wtf/modules/textfile"
+	_ "github.com/wtfutil/wtf/modules/todo"
+	_ "github.com/wtfutil/wtf/modules/todo_plus"
+	_ "github.com/wtfutil/wtf/modules/transmission"
+	_ "github.com/wtfutil/wtf/modules/travisci"
+	_ "github.com/wtfutil/wtf/modules/twitch"
+	_ "github.com/wtfutil/wtf/modules/twitter"
+	_ "github.com/wtfutil/wtf/modules/twitterstats"
+	_ "github.com/wtfutil/wtf/modules/updown"
+	_ "github.com/wtfutil/wtf/modules/uptimerobot"
+	_ "github.com/wtfutil/wtf/modules/urlcheck"
+	_ "github.com/wtfutil/wtf/modules/victorops"
+	_ "github.com/wtfutil/wtf/modules/weatherservices/arpansagovau"
+	_ "github.com/wtfutil/wtf/modules/weatherservices/prettyweather"
+	_ "github.com/wtfutil/wtf/modules/weatherservices/weather"
+	_ "github.com/wtfutil/wtf/modules/zendesk"
+)
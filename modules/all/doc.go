@@ -0,0 +1,22 @@
+// Package all includes every module in the build. Each module registers itself with the
+// app when its package is imported, so importing this package for its side effects makes
+// every module available:
+//
+//	import _ "github.com/wtfutil/wtf/modules/all"
+//
+// To build with only some of the modules, build with `-tags custom_modules`, which
+// excludes all.go, and add a file to this package that imports just the modules you
+// want, guarded by the same build tag:
+//
+//	//go:build custom_modules
+//
+//	package all
+//
+//	import (
+//		_ "github.com/wtfutil/wtf/modules/clocks"
+//		_ "github.com/wtfutil/wtf/modules/todo"
+//	)
+//
+// Private modules that live outside this repository are included the same way, by
+// importing their packages from a file of your own.
+package all
@@ -1,18 +1,21 @@
 package asana
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	asana "bitbucket.org/mikehouston/asana-go"
+	"github.com/wtfutil/wtf/httpclient"
+	"golang.org/x/oauth2"
 )
 
 func fetchTasksFromProject(token, projectId, mode string) ([]*TaskItem, error) {
 	taskItems := []*TaskItem{}
 	uidToName := make(map[string]string)
 
-	client := asana.NewClientWithAccessToken(token)
+	client := newClient(token)
 
 	uid, err := getCurrentUserId(client, mode)
 	if err != nil {
@@ -37,7 +40,7 @@ func fetchTasksFromProjectSections(token, projectId string, sections []string, m
 	taskItems := []*TaskItem{}
 	uidToName := make(map[string]string)
 
-	client := asana.NewClientWithAccessToken(token)
+	client := newClient(token)
 
 	uid, err := getCurrentUserId(client, mode)
 	if err != nil {
@@ -84,7 +87,7 @@ func fetchTasksFromWorkspace(token, workspaceId, mode string) ([]*TaskItem, erro
 	taskItems := []*TaskItem{}
 	uidToName := make(map[string]string)
 
-	client := asana.NewClientWithAccessToken(token)
+	client := newClient(token)
 
 	uid, err := getCurrentUserId(client, mode)
 	if err != nil {
@@ -108,7 +111,7 @@ func fetchTasksFromWorkspace(token, workspaceId, mode string) ([]*TaskItem, erro
 }
 
 func toggleTaskCompletionById(token, taskId string) error {
-	client := asana.NewClientWithAccessToken(token)
+	client := newClient(token)
 
 	t := &asana.Task{
 		ID: taskId,
@@ -137,6 +140,16 @@ func toggleTaskCompletionById(token, taskId string) error {
 	return nil
 }
 
+// newClient returns an Asana client that authenticates with a personal access token and
+// makes its requests with the configured HTTP client
+func newClient(token string) *asana.Client {
+	// The oauth2 client sends its requests through the client in the context
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpclient.Client())
+	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+
+	return asana.NewClient(oauth2.NewClient(ctx, tokenSource))
+}
+
 func processFetchedTasks(client *asana.Client, fetchedTasks *[]*asana.Task, taskItems *[]*TaskItem, uidToName *map[string]string, mode, projectId, uid string) {
 
 	for _, task := range *fetchedTasks {
@@ -71,6 +71,7 @@ func (widget *Widget) Refresh() {
 		widget.settings.sections,
 		widget.settings.allUsers,
 	)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 	} else {
@@ -199,24 +200,24 @@ func (widget *Widget) content() (string, string, bool) {
 				row += fmt.Sprintf(" due: %s", taskItem.dueOn)
 			}
 
-			row += " [white]"
+			row += " [-]"
 
 			str += utils.HighlightableHelper(widget.View, row, idx, len(taskItem.name))
 
 		case taskItem.taskType == TASK_SECTION:
 			if idx > 1 {
-				row := "[white] "
+				row := "[-] "
 
 				str += utils.HighlightableHelper(widget.View, row, idx, len(taskItem.name))
 			}
 			row := fmt.Sprintf(
-				"[white] %s [white]",
+				"[-] %s [-]",
 				taskItem.name,
 			)
 
 			str += utils.HighlightableHelper(widget.View, row, idx, len(taskItem.name))
 
-			row = "[white] "
+			row = "[-] "
 
 			str += utils.HighlightableHelper(widget.View, row, idx, len(taskItem.name))
 
@@ -8,15 +8,20 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (widget *Widget) getBuildStats() string {
+// getBuildStats returns the builds to display. If they can't be fetched, the error is
+// also returned as the text to display
+func (widget *Widget) getBuildStats() (string, error) {
 	projName := widget.settings.projectName
 	statusFilter := azrBuild.BuildStatusValues.All
 	top := widget.settings.maxRows
 	builds, err := widget.cli.GetBuilds(widget.ctx, azrBuild.GetBuildsArgs{Project: &projName, StatusFilter: &statusFilter, Top: &top})
 	if err != nil {
-		return errors.Wrap(err, "could not get builds").Error()
+		err = errors.Wrap(err, "could not get builds")
+		return err.Error(), err
 	}
 
+	roles := widget.settings.Colors.RoleTheme
+
 	result := ""
 	for _, build := range builds.Value {
 		num := *build.BuildNumber
@@ -38,9 +43,9 @@ func (widget *Widget) getBuildStats() string {
 		} else if status == azrBuild.BuildStatusValues.Completed {
 			buildResult := *build.Result
 			if buildResult == azrBuild.BuildResultValues.Succeeded {
-				statusDisplay = "[white:green]succeeded"
+				statusDisplay = "[white:" + roles.Ok + "]succeeded"
 			} else if buildResult == azrBuild.BuildResultValues.Failed {
-				statusDisplay = "[white:red]failed"
+				statusDisplay = "[white:" + roles.Error + "]failed"
 			} else if buildResult == azrBuild.BuildResultValues.Canceled {
 				statusDisplay = "[white:darkgrey]cancelled"
 			} else if buildResult == azrBuild.BuildResultValues.PartiallySucceeded {
@@ -55,5 +60,5 @@ func (widget *Widget) getBuildStats() string {
 		result = "no builds found"
 	}
 
-	return result
+	return result, nil
 }
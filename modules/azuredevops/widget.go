@@ -31,7 +31,9 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 	cli, err := azrBuild.NewClient(ctx, connection)
 	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client 2").Error()
+		err = errors.Wrap(err, "could not create client 2")
+		widget.displayBuffer = err.Error()
+		widget.SetRefreshError(err)
 	} else {
 		widget.cli = cli
 		widget.ctx = ctx
@@ -62,5 +64,8 @@ func (widget *Widget) refreshDisplayBuffer() {
 		widget.settings.labelColor,
 		widget.settings.projectName)
 
-	widget.displayBuffer += widget.getBuildStats()
+	buildStats, err := widget.getBuildStats()
+	widget.SetRefreshError(err)
+
+	widget.displayBuffer += buildStats
 }
@@ -26,15 +26,15 @@ func NewClient(url string, apiKey string, subdomain string) *Client {
 /* -------------------- Public Functions -------------------- */
 
 // Away returns a string representation of the people who are out of the office during the defined period
-func (client *Client) Away(itemType, startDate, endDate string) []Item {
+func (client *Client) Away(itemType, startDate, endDate string) ([]Item, error) {
 	calendar, err := client.getWhoIsAway(startDate, endDate)
 	if err != nil {
-		return []Item{}
+		return []Item{}, err
 	}
 
 	items := calendar.ItemsByType(itemType)
 
-	return items
+	return items, nil
 }
 
 /* -------------------- Private Functions -------------------- */
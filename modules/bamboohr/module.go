@@ -0,0 +1,20 @@
+package bamboohr
+
+import (
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+func init() {
+	wtf.RegisterModule(wtf.ModuleDefinition{
+		DocPath: "bamboohr",
+		NewSettings: func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+			return NewSettingsFromYAML(name, moduleConfig, globalConfig)
+		},
+		NewWidget: func(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings interface{}) wtf.Wtfable {
+			return NewWidget(tviewApp, redrawChan, settings.(*Settings))
+		},
+		Type: "bamboohr",
+	})
+}
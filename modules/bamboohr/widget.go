@@ -38,21 +38,28 @@ func (widget *Widget) Refresh() {
 		widget.settings.subdomain,
 	)
 
-	widget.items = client.Away(
+	items, err := client.Away(
 		"timeOff",
 		time.Now().Local().Format(wtf.DateFormat),
 		time.Now().Local().Format(wtf.DateFormat),
 	)
 
+	widget.items = items
+	widget.SetRefreshError(err)
+
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
+	if err := widget.RefreshError(); err != nil {
+		return widget.CommonSettings().Title, err.Error(), true
+	}
+
 	str := ""
 	if len(widget.items) == 0 {
-		str = fmt.Sprintf("\n\n\n\n\n\n\n\n%s", utils.CenterText("[grey]no one[white]", 50))
+		str = fmt.Sprintf("\n\n\n\n\n\n\n\n%s", utils.CenterText("[grey]no one[-]", 50))
 	} else {
 		for _, item := range widget.items {
 			str += widget.format(item)
@@ -64,11 +71,12 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) format(item Item) string {
 	var str string
+	nameColor := widget.settings.Colors.RoleTheme.Accent
 
 	if item.IsOneDay() {
-		str = fmt.Sprintf(" [green]%s[white]\n %s\n\n", item.Name(), item.PrettyEnd())
+		str = fmt.Sprintf(" [%s]%s[-]\n %s\n\n", nameColor, item.Name(), item.PrettyEnd())
 	} else {
-		str = fmt.Sprintf(" [green]%s[white]\n %s - %s\n\n", item.Name(), item.PrettyStart(), item.PrettyEnd())
+		str = fmt.Sprintf(" [%s]%s[-]\n %s - %s\n\n", nameColor, item.Name(), item.PrettyStart(), item.PrettyEnd())
 	}
 
 	return str
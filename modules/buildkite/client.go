@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -49,9 +50,7 @@ func (widget *Widget) recentBuilds(pipeline PipelineSettings) ([]Build, error) {
 	}
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", widget.settings.apiKey))
 
-	httpClient := &http.Client{Transport: &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-	}}
+	httpClient := httpclient.Client()
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
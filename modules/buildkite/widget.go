@@ -32,6 +32,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 func (widget *Widget) Refresh() {
 	builds, err := widget.getBuilds()
 
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.builds = nil
@@ -51,7 +52,7 @@ func (widget *Widget) display() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s - [green]%s", widget.CommonSettings().Title, widget.settings.orgSlug)
+	title := fmt.Sprintf("%s - [%s]%s", widget.CommonSettings().Title, widget.settings.Colors.RoleTheme.Accent, widget.settings.orgSlug)
 
 	if widget.err != nil {
 		return title, widget.err.Error(), true
@@ -59,5 +60,5 @@ func (widget *Widget) content() (string, string, bool) {
 
 	displayData := newPipelinesDisplayData(widget.builds)
 
-	return title, displayData.Content(), false
+	return title, displayData.Content(widget.settings.Colors.RoleTheme), false
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ovh/cds/sdk"
+	"github.com/wtfutil/wtf/cfg"
 )
 
 func (widget *Widget) display() {
@@ -35,7 +36,7 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) title(workflow *sdk.Workflow) string {
 	return fmt.Sprintf(
-		"[%s]%s/%s[white]",
+		"[%s]%s/%s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		workflow.ProjectKey, workflow.Name,
 	)
@@ -47,7 +48,7 @@ func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
 	widget.SetItemCount(len(runs))
 
 	if len(runs) == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	content := ""
@@ -65,7 +66,7 @@ func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
 				tags = fmt.Sprintf("%s%s:%s ", tags, tag.Tag, tag.Value)
 			}
 		}
-		content += fmt.Sprintf(`[%s]["%d"]%d %-6s[""][gray] %s`, getStatusColor(run.Status), idx, run.Number, run.Status, tags)
+		content += fmt.Sprintf(`[%s]["%d"]%d %-6s[""][gray] %s`, getStatusColor(run.Status, widget.settings.Colors.RoleTheme), idx, run.Number, run.Status, tags)
 		content += "\n"
 		widget.Items = append(widget.Items, run.Number)
 	}
@@ -73,20 +74,20 @@ func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
 	return content
 }
 
-func getStatusColor(status string) string {
+func getStatusColor(status string, roles cfg.RoleTheme) string {
 	switch status {
 	case sdk.StatusSuccess:
-		return "green"
+		return roles.Ok
 	case sdk.StatusBuilding, sdk.StatusWaiting:
-		return "blue"
+		return roles.Accent
 	case sdk.StatusFail:
-		return "red"
+		return roles.Error
 	case sdk.StatusStopped:
-		return "red"
+		return roles.Error
 	case sdk.StatusSkipped:
-		return "grey"
+		return roles.Muted
 	case sdk.StatusDisabled:
-		return "grey"
+		return roles.Muted
 	}
-	return "red"
+	return roles.Error
 }
@@ -33,7 +33,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		hideTags: utils.ToStrs(ymlConfig.UList("hideTags")),
 	}
 
-	settings.SetDocumentationPath("cds/favorites")
-
 	return &settings
 }
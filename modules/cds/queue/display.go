@@ -34,7 +34,7 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) title(filter string) string {
 	return fmt.Sprintf(
-		"[%s]%d - %s[white]",
+		"[%s]%d - %s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		widget.maxItems,
 		filter,
@@ -47,7 +47,7 @@ func (widget *Widget) displayQueue(filter string) string {
 	widget.SetItemCount(len(runs))
 
 	if len(runs) == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	var content string
@@ -88,9 +88,9 @@ func (widget *Widget) generateQueueJobLine(parameters []sdk.Parameter, executedJ
 	c := "grey"
 	if status == sdk.StatusWaiting {
 		if duration > 120*time.Second {
-			c = "red"
+			c = widget.settings.Colors.RoleTheme.Error
 		} else if duration > 50*time.Second {
-			c = "yellow"
+			c = widget.settings.Colors.RoleTheme.Warn
 		}
 	}
 
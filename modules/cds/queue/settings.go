@@ -30,7 +30,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		apiURL: ymlConfig.UString("apiURL", os.Getenv("CDS_API_URL")),
 	}
 
-	settings.SetDocumentationPath("cds/queue")
-
 	return &settings
 }
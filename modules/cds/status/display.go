@@ -26,9 +26,10 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) displayStatus() string {
 	status, err := widget.client.MonStatus()
+	widget.SetRefreshError(err)
 
 	if err != nil || len(status.Lines) == 0 {
-		return fmt.Sprintf(" [red]Error: %v[white]\n", err.Error())
+		return fmt.Sprintf(" [%s]Error: %v[-]\n", widget.settings.Colors.RoleTheme.Error, err)
 	}
 
 	widget.SetItemCount(len(status.Lines))
@@ -58,14 +59,16 @@ func (widget *Widget) displayStatus() string {
 			red = append(red, line.String())
 		}
 	}
+	roles := widget.settings.Colors.RoleTheme
+
 	var idx int
 	var content string
 	for _, v := range globalRed {
-		content += fmt.Sprintf("[grey][\"%d\"][red]%s\n", idx, v)
+		content += fmt.Sprintf("[grey][\"%d\"][%s]%s\n", idx, roles.Error, v)
 		idx++
 	}
 	for _, v := range globalWarn {
-		content += fmt.Sprintf("[grey][\"%d\"][yellow]%s\n", idx, v)
+		content += fmt.Sprintf("[grey][\"%d\"][%s]%s\n", idx, roles.Warn, v)
 		idx++
 	}
 	for _, v := range global {
@@ -73,11 +76,11 @@ func (widget *Widget) displayStatus() string {
 		idx++
 	}
 	for _, v := range red {
-		content += fmt.Sprintf("[grey][\"%d\"][red]%s\n", idx, v)
+		content += fmt.Sprintf("[grey][\"%d\"][%s]%s\n", idx, roles.Error, v)
 		idx++
 	}
 	for _, v := range warn {
-		content += fmt.Sprintf("[grey][\"%d\"][yellow]%s\n", idx, v)
+		content += fmt.Sprintf("[grey][\"%d\"][%s]%s\n", idx, roles.Warn, v)
 		idx++
 	}
 	for _, v := range ok {
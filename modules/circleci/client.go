@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -57,7 +58,7 @@ func (client *Client) circleRequest(path string) ([]byte, error) {
 		return nil, err
 	}
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -39,6 +40,7 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	builds, err := widget.Client.BuildsFor()
+	widget.SetRefreshError(err)
 
 	title := fmt.Sprintf("%s - Builds", widget.CommonSettings().Title)
 	var str string
@@ -47,36 +49,65 @@ func (widget *Widget) content() (string, string, bool) {
 		wrap = true
 		str = err.Error()
 	} else {
+		shown := []*Build{}
+
 		for idx, build := range builds {
 			if idx > widget.settings.numberOfBuilds {
 				break
 			}
+			shown = append(shown, build)
 
 			str += fmt.Sprintf(
-				"[%s] %s-%d (%s) [white]%s\n",
-				buildColor(build),
+				"[%s] %s-%d (%s) [-]%s\n",
+				widget.buildColor(build),
 				build.Reponame,
 				build.BuildNum,
 				build.Branch,
 				build.AuthorName,
 			)
 		}
+
+		widget.RaiseEvents(failedBuildEvents(shown))
 	}
 
 	return title, str, wrap
 }
 
-func buildColor(build *Build) string {
+// failedBuildEvents turns the failed builds into events, so that new failures can be
+// alerted on
+func failedBuildEvents(builds []*Build) []notify.Event {
+	events := []notify.Event{}
+
+	for _, build := range builds {
+		if build.Status != "failed" {
+			continue
+		}
+
+		events = append(events, notify.Event{
+			Key:      fmt.Sprintf("%s-%d", build.Reponame, build.BuildNum),
+			Kind:     "build-failed",
+			Message:  fmt.Sprintf("Build %d on %s by %s failed", build.BuildNum, build.Branch, build.AuthorName),
+			Severity: notify.SeverityError,
+			Title:    build.Reponame,
+		})
+	}
+
+	return events
+}
+
+func (widget *Widget) buildColor(build *Build) string {
+	roles := widget.settings.Colors.RoleTheme
+
 	switch build.Status {
 	case "failed":
-		return "red"
+		return roles.Error
 	case "running":
-		return "yellow"
+		return roles.Warn
 	case "success":
-		return "green"
+		return roles.Ok
 	case "fixed":
-		return "green"
+		return roles.Ok
 	default:
-		return "white"
+		return "-"
 	}
 }
@@ -17,7 +17,7 @@ func (widget *Widget) display(clocks []Clock, dateFormat string, timeFormat stri
 	} else {
 		for idx, clock := range clocks {
 			str += fmt.Sprintf(
-				" [%s]%-*s %-10s %7s[white]\n",
+				" [%s]%-*s %-10s %7s[-]\n",
 				widget.CommonSettings().RowColor(idx),
 				locationWidth,
 				clock.Label,
@@ -13,7 +13,6 @@ import (
 
 	"github.com/creack/pty"
 	"github.com/rivo/tview"
-	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -63,6 +62,17 @@ func (widget *Widget) Refresh() {
 	}
 }
 
+// Resize runs the command again with the widget's new size in WTF_WIDGET_WIDTH and
+// WTF_WIDGET_HEIGHT. A command that's still running keeps the size it started with
+func (widget *Widget) Resize(width, height int) {
+	widget.m.Lock()
+	widget.settings.width = width
+	widget.settings.height = height
+	widget.m.Unlock()
+
+	widget.Refresh()
+}
+
 // String returns the string representation of the widget
 func (widget *Widget) String() string {
 	args := strings.Join(widget.settings.args, " ")
@@ -110,6 +120,9 @@ func (widget *Widget) drainLines(n int) error {
 }
 
 func (widget *Widget) environment() []string {
+	widget.m.Lock()
+	defer widget.m.Unlock()
+
 	envs := os.Environ()
 	envs = append(
 		envs,
@@ -137,6 +150,7 @@ func runCommandLoop(widget *Widget) {
 		if err != nil {
 			widget.handleError(err)
 		}
+		widget.SetRefreshError(err)
 		widget.redrawChan <- true
 	}
 }
@@ -162,7 +176,7 @@ func runCommandPty(widget *Widget, cmd *exec.Cmd) error {
 	go func() {
 		for range ch {
 			if err := pty.InheritSize(os.Stdin, f); err != nil {
-				logger.Log(fmt.Sprintf("error resizing pty: %s", err))
+				widget.Logger().Errorf("error resizing pty: %s", err)
 			}
 		}
 	}()
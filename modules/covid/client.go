@@ -2,8 +2,8 @@ package covid
 
 import (
 	"fmt"
-	"net/http"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -12,7 +12,7 @@ const covidTrackerAPIURL = "https://coronavirus-tracker-api.herokuapp.com/v2/"
 // LatestCases queries the /latest endpoint, does not take any query parameters
 func LatestCases() (*Cases, error) {
 	latestURL := covidTrackerAPIURL + "latest"
-	resp, err := http.Get(latestURL)
+	resp, err := httpclient.Client().Get(latestURL)
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf(resp.Status)
 	}
@@ -35,7 +35,7 @@ func (widget *Widget) LatestCountryCases(countries []interface{}) ([]*Cases, err
 	countriesCovidData := []*Cases{}
 	for _, name := range countries {
 		countryURL := covidTrackerAPIURL + "locations?source=jhu&country_code=" + name.(string)
-		resp, err := http.Get(countryURL)
+		resp, err := httpclient.Client().Get(countryURL)
 		if resp.StatusCode != 200 {
 			return nil, fmt.Errorf(resp.Status)
 		}
@@ -58,13 +58,15 @@ func (widget *Widget) content() (string, string, bool) {
 		title = widget.CommonSettings().Title
 	}
 
+	widget.err = nil
+
 	cases, err := LatestCases()
 	var covidStats string
 	if err != nil {
 		widget.err = err
 	} else {
 		// Display global stats
-		covidStats = fmt.Sprintf("[%s]Global[white]\n", widget.settings.Colors.Subheading)
+		covidStats = fmt.Sprintf("[%s]Global[-]\n", widget.settings.Colors.Subheading)
 		covidStats += fmt.Sprintf("%s: %s\n", "Confirmed", widget.displayStats(cases.Latest.Confirmed))
 		covidStats += fmt.Sprintf("%s: %s\n", "Deaths", widget.displayStats(cases.Latest.Deaths))
 	}
@@ -75,12 +77,14 @@ func (widget *Widget) content() (string, string, bool) {
 			widget.err = err
 		} else {
 			for i, name := range countryCases {
-				covidStats += fmt.Sprintf("[%s]Country[white]: %s\n", widget.settings.Colors.Subheading, widget.settings.countries[i])
+				covidStats += fmt.Sprintf("[%s]Country[-]: %s\n", widget.settings.Colors.Subheading, widget.settings.countries[i])
 				covidStats += fmt.Sprintf("%s: %s\n", "Confirmed", widget.displayStats(name.Latest.Confirmed))
 				covidStats += fmt.Sprintf("%s: %s\n", "Deaths", widget.displayStats(name.Latest.Deaths))
 			}
 		}
 	}
 
+	widget.SetRefreshError(widget.err)
+
 	return title, covidStats, true
 }
@@ -63,7 +63,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		settings.summary.currencies[key] = currency
 	}
 
-	settings.SetDocumentationPath("cryptocurrencies/bittrex")
-
 	return &settings
 }
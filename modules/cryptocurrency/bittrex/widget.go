@@ -2,12 +2,14 @@ package bittrex
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"net/http"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -82,6 +84,12 @@ func makeMarketCurrency(name string) *mCurrency {
 func (widget *Widget) Refresh() {
 	widget.updateSummary()
 
+	if ok {
+		widget.SetRefreshError(nil)
+	} else {
+		widget.SetRefreshError(errors.New(errorText))
+	}
+
 	widget.display()
 }
 
@@ -95,9 +103,8 @@ func (widget *Widget) updateSummary() {
 		}
 	}()
 
-	client := &http.Client{
-		Timeout: 5 * time.Second,
-	}
+	client := httpclient.Client()
+	client.Timeout = 5 * time.Second
 
 	for _, baseCurrency := range widget.summaryList.items {
 		for _, mCurrency := range baseCurrency.markets {
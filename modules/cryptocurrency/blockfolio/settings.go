@@ -34,7 +34,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		displayHoldings: ymlConfig.UBool("displayHoldings", true),
 	}
 
-	settings.SetDocumentationPath("cryptocurrencies/blockfolio")
-
 	return &settings
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -39,6 +40,7 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 func (widget *Widget) content() (string, string, bool) {
 	positions, err := Fetch(widget.device_token)
+	widget.SetRefreshError(err)
 	title := widget.CommonSettings().Title
 	if err != nil {
 		return title, err.Error(), true
@@ -82,7 +84,7 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 
 	if widget.settings.displayHoldings {
-		res += fmt.Sprintf("\n[%s]Total value: $%.3fk", "green", totalFiat/1000)
+		res += fmt.Sprintf("\n[%s]Total value: $%.3fk", widget.settings.Colors.RoleTheme.Ok, totalFiat/1000)
 	}
 
 	return title, res, true
@@ -104,7 +106,7 @@ type AllPositionsResponse struct {
 }
 
 func MakeApiRequest(token string, method string) ([]byte, error) {
-	client := &http.Client{}
+	client := httpclient.Client()
 	url := "https://api-v0.blockfolio.com/rest/" + method + "/" + token + "?use_alias=true&fiat_currency=USD"
 	req, err := http.NewRequest("GET", url, http.NoBody)
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"sync"
 	"time"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 var baseURL = "https://min-api.cryptocompare.com/data/price"
@@ -105,14 +107,10 @@ func (widget *Widget) updateCurrencies() {
 	}()
 	for _, fromCurrency := range widget.list.items {
 
-		var (
-			client       http.Client
-			jsonResponse cResponse
-		)
+		var jsonResponse cResponse
 
-		client = http.Client{
-			Timeout: 5 * time.Second,
-		}
+		client := httpclient.Client()
+		client.Timeout = 5 * time.Second
 
 		request := makeRequest(fromCurrency)
 		response, err := client.Do(request)
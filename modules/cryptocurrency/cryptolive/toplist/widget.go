@@ -7,6 +7,8 @@ import (
 	"os"
 	"sync"
 	"time"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 var baseURL = "https://min-api.cryptocompare.com/data/top/exchanges"
@@ -73,9 +75,8 @@ func (widget *Widget) updateData() {
 		}
 	}()
 
-	client := &http.Client{
-		Timeout: 5 * time.Second,
-	}
+	client := httpclient.Client()
+	client.Timeout = 5 * time.Second
 
 	for _, fromCurrency := range widget.list.items {
 		for _, toCurrency := range fromCurrency.to {
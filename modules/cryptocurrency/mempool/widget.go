@@ -2,9 +2,9 @@ package mempool
 
 import (
 	"fmt"
-	"net/http"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
@@ -44,23 +44,28 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() string {
-	return getBTCTxFees()
+	fees, err := getBTCTxFees()
+	widget.SetRefreshError(err)
+
+	return fees
 }
 
-func getBTCTxFees() string {
+// getBTCTxFees returns the fees to display. If they can't be fetched, a message saying so
+// is returned as the text to display, along with the error
+func getBTCTxFees() (string, error) {
 	url := "https://mempool.space/api/v1/fees/recommended"
-	resp, err := http.Get(url)
+	resp, err := httpclient.Client().Get(url)
 	if err != nil {
-		logger.Log(fmt.Sprintf("[mempool] Error: Failed to make request to mempool. Reason: %s", err))
-		return "[mempool] error callng mempool API"
+		logger.Module("mempool").Errorf("Failed to make request to mempool. Reason: %s", err)
+		return "[mempool] error callng mempool API", err
 	}
 	defer resp.Body.Close()
 
 	parsed := feeStruct{}
 	err = utils.ParseJSON(&parsed, resp.Body)
 	if err != nil {
-		logger.Log(fmt.Sprintf("[mempool] Error: Failed to decode JSON data from mempool. Reason: %s", err))
-		return "[mempool] error parsing JSON from mempool API"
+		logger.Module("mempool").Errorf("Failed to decode JSON data from mempool. Reason: %s", err)
+		return "[mempool] error parsing JSON from mempool API", err
 	}
 
 	finalStr := ""
@@ -69,7 +74,7 @@ func getBTCTxFees() string {
 	finalStr += fmt.Sprintf("%-7s %2d sat/vB\n", "60 min", parsed.HourFee)
 	finalStr += fmt.Sprintf("%-7s %2d sat/vB\n", "Eco", parsed.EcoFee)
 
-	return finalStr
+	return finalStr, nil
 }
 
 func (widget *Widget) display() {
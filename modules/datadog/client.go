@@ -1,6 +1,7 @@
 package datadog
 
 import (
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	datadog "github.com/zorkian/go-datadog-api"
 )
@@ -11,6 +12,7 @@ func (widget *Widget) Monitors() ([]datadog.Monitor, error) {
 		widget.settings.apiKey,
 		widget.settings.applicationKey,
 	)
+	client.HttpClient = httpclient.Client()
 
 	tags := utils.ToStrs(widget.settings.tags)
 
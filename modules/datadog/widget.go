@@ -35,6 +35,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 func (widget *Widget) Refresh() {
 	widget.err = nil
 	monitors, monitorErr := widget.Monitors()
+	widget.SetRefreshError(monitorErr)
 
 	if monitorErr != nil {
 		widget.monitors = nil
@@ -77,13 +78,14 @@ func (widget *Widget) content() (string, string, bool) {
 		str += fmt.Sprintf(
 			" %s\n",
 			fmt.Sprintf(
-				"[%s]Triggered Monitors[white]",
+				"[%s]Triggered Monitors[-]",
 				widget.settings.Colors.Subheading,
 			),
 		)
 		for idx, triggeredMonitor := range triggeredMonitors {
-			row := fmt.Sprintf(`[%s][red] %s[%s]`,
+			row := fmt.Sprintf(`[%s][%s] %s[%s]`,
 				widget.RowColor(idx),
+				widget.settings.Colors.RoleTheme.Error,
 				*triggeredMonitor.Name,
 				widget.RowColor(idx),
 			)
@@ -92,7 +94,7 @@ func (widget *Widget) content() (string, string, bool) {
 	} else {
 		str += fmt.Sprintf(
 			" %s\n",
-			"[green]No Triggered Monitors[white]",
+			fmt.Sprintf("[%s]No Triggered Monitors[-]", widget.settings.Colors.RoleTheme.Ok),
 		)
 	}
 
@@ -50,6 +50,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	articles, err := c.Articles.List(ctx, options)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.articles = nil
@@ -97,7 +98,7 @@ func (widget *Widget) content() (string, string, bool) {
 	var str string
 	for idx, article := range articles {
 		row := fmt.Sprintf(
-			`[%s]%2d. %s [lightblue](%s)[white]`,
+			`[%s]%2d. %s [lightblue](%s)[-]`,
 			widget.RowColor(idx),
 			idx+1,
 			article.Title,
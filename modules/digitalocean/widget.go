@@ -7,6 +7,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"golang.org/x/oauth2"
@@ -88,6 +89,7 @@ func (widget *Widget) Prev() {
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
 	err := widget.Fetch()
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.SetItemCount(0)
@@ -113,7 +115,10 @@ func (widget *Widget) createClient() {
 		AccessToken: widget.settings.apiKey,
 	}
 
-	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
+	// The oauth2 client sends its requests through the client in the context
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpclient.Client())
+
+	oauthClient := oauth2.NewClient(ctx, tokenSource)
 	widget.client = godo.NewClient(oauthClient)
 }
 
@@ -11,15 +11,19 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (widget *Widget) getSystemInfo() string {
+// getSystemInfo returns the docker system information to display. If the information
+// can't be fetched, the error is also returned as the text to display
+func (widget *Widget) getSystemInfo() (string, error) {
 	info, err := widget.cli.Info(context.Background())
 	if err != nil {
-		return errors.Wrap(err, "could not get docker system info").Error()
+		err = errors.Wrap(err, "could not get docker system info")
+		return err.Error(), err
 	}
 
 	diskUsage, err := widget.cli.DiskUsage(context.Background(), types.DiskUsageOptions{})
 	if err != nil {
-		return errors.Wrap(err, "could not get disk usage").Error()
+		err = errors.Wrap(err, "could not get disk usage")
+		return err.Error(), err
 	}
 
 	var duContainer int64
@@ -51,9 +55,10 @@ func (widget *Widget) getSystemInfo() string {
 		},
 		{
 			name: "containers:",
-			value: fmt.Sprintf("[lime]%d[white]/[yellow]%d[white]/[red]%d",
-				info.ContainersRunning,
-				info.ContainersPaused, info.ContainersStopped),
+			value: fmt.Sprintf("[%s]%d[-]/[%s]%d[-]/[%s]%d",
+				widget.settings.Colors.RoleTheme.Ok, info.ContainersRunning,
+				widget.settings.Colors.RoleTheme.Warn, info.ContainersPaused,
+				widget.settings.Colors.RoleTheme.Error, info.ContainersStopped),
 		},
 		{
 			name:  "images:",
@@ -104,27 +109,31 @@ func (widget *Widget) getSystemInfo() string {
 		result += fmt.Sprintf("[%s]%s %s\n", widget.settings.labelColor, info.name, info.value)
 	}
 
-	return result
+	return result, nil
 }
 
-func (widget *Widget) getContainerStates() string {
+// getContainerStates returns the list of containers and their states to display. If the
+// list can't be fetched, the error is also returned as the text to display
+func (widget *Widget) getContainerStates() (string, error) {
 	cntrs, err := widget.cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
 	if err != nil {
-		return errors.Wrapf(err, " could not get container list").Error()
+		err = errors.Wrapf(err, " could not get container list")
+		return err.Error(), err
 	}
 
 	if len(cntrs) == 0 {
-		return " no containers"
+		return " no containers", nil
 	}
 
+	roles := widget.settings.Colors.RoleTheme
 	colorMap := map[string]string{
-		"created":    "green",
-		"running":    "lime",
-		"paused":     "yellow",
-		"restarting": "yellow",
-		"removing":   "yellow",
-		"exited":     "red",
-		"dead":       "red",
+		"created":    roles.Muted,
+		"running":    roles.Ok,
+		"paused":     roles.Warn,
+		"restarting": roles.Warn,
+		"removing":   roles.Warn,
+		"exited":     roles.Error,
+		"dead":       roles.Error,
 	}
 
 	containers := []struct {
@@ -156,8 +165,8 @@ func (widget *Widget) getContainerStates() string {
 
 	result := ""
 	for _, c := range containers {
-		result += fmt.Sprintf("[white]%s [%s]%s\n", c.name, colorMap[c.state], c.state)
+		result += fmt.Sprintf("[-]%s [%s]%s\n", c.name, colorMap[c.state], c.state)
 	}
 
-	return result
+	return result, nil
 }
@@ -26,7 +26,9 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 	cli, err := client.NewClientWithOpts()
 	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client").Error()
+		err = errors.Wrap(err, "could not create client")
+		widget.displayBuffer = err.Error()
+		widget.SetRefreshError(err)
 	} else {
 		widget.cli = cli
 	}
@@ -54,13 +56,22 @@ func (widget *Widget) refreshDisplayBuffer() {
 		return
 	}
 
+	systemInfo, systemErr := widget.getSystemInfo()
+	containerStates, containerErr := widget.getContainerStates()
+
 	widget.displayBuffer = ""
 
-	widget.displayBuffer += fmt.Sprintf("[%s] System[white]\n", widget.settings.Colors.Subheading)
-	widget.displayBuffer += widget.getSystemInfo()
+	widget.displayBuffer += fmt.Sprintf("[%s] System[-]\n", widget.settings.Colors.Subheading)
+	widget.displayBuffer += systemInfo
 
 	widget.displayBuffer += "\n"
 
-	widget.displayBuffer += fmt.Sprintf("[%s] Containers[white]\n", widget.settings.Colors.Subheading)
-	widget.displayBuffer += widget.getContainerStates()
+	widget.displayBuffer += fmt.Sprintf("[%s] Containers[-]\n", widget.settings.Colors.Subheading)
+	widget.displayBuffer += containerStates
+
+	if systemErr != nil {
+		widget.SetRefreshError(systemErr)
+	} else {
+		widget.SetRefreshError(containerErr)
+	}
 }
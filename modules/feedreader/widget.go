@@ -1,16 +1,15 @@
 package feedreader
 
 import (
-	"crypto/tls"
 	"fmt"
 	"html"
-	"net/http"
 	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/mmcdole/gofeed"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"jaytaylor.com/html2text"
@@ -24,6 +23,9 @@ const (
 	SHOW_CONTENT
 )
 
+// showTypeStateKey names what the stories display in the widget's UI state
+const showTypeStateKey = "showType"
+
 // FeedItem represents an item returned from an RSS or Atom feed
 type FeedItem struct {
 	item        *gofeed.Item
@@ -58,20 +60,12 @@ func rotateShowType(showtype ShowType) ShowType {
 // NewWidget creates a new instance of a widget
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	parser := gofeed.NewParser()
+	parser.Client = httpclient.Client()
 	if settings.disableHTTP2 {
-		// If HTTP/2 is disabled, we override the parser client
-		// with a client using a simple HTTP transport which
-		// removes the client's default behavior of first
-		// trying HTTP/2 before downgrading to older protocol
-		// versions.
-		parser.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS12,
-					MaxVersion: tls.VersionTLS13,
-				},
-			},
-		}
+		// If HTTP/2 is disabled, we use a client that removes
+		// the default behavior of first trying HTTP/2 before
+		// downgrading to older protocol versions.
+		parser.Client = httpclient.HTTP1Client()
 	}
 
 	parser.UserAgent = settings.userAgent
@@ -84,6 +78,8 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		showType: SHOW_TITLE,
 	}
 
+	widget.LoadState(showTypeStateKey, &widget.showType)
+
 	widget.SetRenderFunction(widget.Render)
 	widget.initializeKeyboardControls()
 
@@ -113,6 +109,7 @@ func (widget *Widget) Fetch(feedURLs []string) ([]*FeedItem, error) {
 // Refresh updates the data in the widget
 func (widget *Widget) Refresh() {
 	feedItems, err := widget.Fetch(widget.settings.feeds)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.stories = nil
@@ -199,7 +196,7 @@ func (widget *Widget) content() (string, string, bool) {
 		displayText := widget.getShowText(feedItem, rowColor)
 
 		row := fmt.Sprintf(
-			"[%s]%2d. %s[white]",
+			"[%s]%2d. %s[-]",
 			rowColor,
 			idx+1,
 			displayText,
@@ -266,5 +263,6 @@ func (widget *Widget) openStory() {
 
 func (widget *Widget) toggleDisplayText() {
 	widget.showType = rotateShowType(widget.showType)
+	widget.SaveState(showTypeStateKey, widget.showType)
 	widget.Render()
 }
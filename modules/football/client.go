@@ -3,6 +3,8 @@ package football
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 var (
@@ -36,7 +38,7 @@ func (client *Client) footballRequest(path string, id int) (*http.Response, erro
 	if err != nil {
 		return nil, err
 	}
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -69,6 +69,8 @@ func (widget *Widget) content() (string, string, bool) {
 	var content string
 	title := fmt.Sprintf("%s %s", widget.CommonSettings().Title, widget.League.caption)
 	wrap := false
+
+	widget.SetRefreshError(widget.err)
 	if widget.err != nil {
 		return title, widget.err.Error(), true
 	}
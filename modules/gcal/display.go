@@ -49,7 +49,7 @@ func (widget *Widget) content() (string, string, bool) {
 		)
 
 		lineOne := fmt.Sprintf(
-			"%s %s %s %s[white]\n",
+			"%s %s %s %s[-]\n",
 			widget.dayDivider(calEvent, prevEvent),
 			widget.responseIcon(calEvent),
 			timestamp,
@@ -155,11 +155,11 @@ func (widget *Widget) timeUntil(calEvent *CalEvent) string {
 	default:
 		untilStr = fmt.Sprintf("%dm", mins)
 		if mins < 30 {
-			color = "[red]"
+			color = "[" + widget.settings.Colors.RoleTheme.Error + "]"
 		}
 	}
 
-	return color + untilStr + "[white]"
+	return color + untilStr + "[-]"
 }
 
 func (widget *Widget) titleColor(calEvent *CalEvent) string {
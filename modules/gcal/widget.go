@@ -52,6 +52,7 @@ func (widget *Widget) fetchAndDisplayEvents() {
 		widget.err = nil
 		widget.calEvents = calEvents
 	}
+	widget.SetRefreshError(widget.err)
 
 	widget.display()
 }
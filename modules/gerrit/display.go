@@ -23,13 +23,13 @@ func (widget *Widget) content() (string, string, bool) {
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.GerritProjects), widget.Idx, width) + "\n"
-	str += fmt.Sprintf(" [%s]Stats[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Stats[-]\n", widget.settings.Colors.Subheading)
 	str += widget.displayStats(project)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]Open Incoming Reviews[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Open Incoming Reviews[-]\n", widget.settings.Colors.Subheading)
 	str += widget.displayMyIncomingReviews(project)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]My Outgoing Reviews[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]My Outgoing Reviews[-]\n", widget.settings.Colors.Subheading)
 	str += widget.displayMyOutgoingReviews(project)
 
 	return title, str, false
@@ -37,12 +37,12 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) displayMyIncomingReviews(project *GerritProject) string {
 	if len(project.IncomingReviews) == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	str := ""
 	for idx, r := range project.IncomingReviews {
-		str += fmt.Sprintf(" [%s] [green]%d[white] [%s] %s\n", widget.rowColor(idx), r.Number, widget.rowColor(idx), r.Subject)
+		str += fmt.Sprintf(" [%s] [%s]%d[-] [%s] %s\n", widget.rowColor(idx), widget.settings.Colors.RoleTheme.Accent, r.Number, widget.rowColor(idx), r.Subject)
 	}
 
 	return str
@@ -50,12 +50,12 @@ func (widget *Widget) displayMyIncomingReviews(project *GerritProject) string {
 
 func (widget *Widget) displayMyOutgoingReviews(project *GerritProject) string {
 	if len(project.OutgoingReviews) == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	str := ""
 	for idx, r := range project.OutgoingReviews {
-		str += fmt.Sprintf(" [%s] [green]%d[white] [%s] %s\n", widget.rowColor(idx+len(project.IncomingReviews)), r.Number, widget.rowColor(idx+len(project.IncomingReviews)), r.Subject)
+		str += fmt.Sprintf(" [%s] [%s]%d[-] [%s] %s\n", widget.rowColor(idx+len(project.IncomingReviews)), widget.settings.Colors.RoleTheme.Accent, r.Number, widget.rowColor(idx+len(project.IncomingReviews)), r.Subject)
 	}
 
 	return str
@@ -79,5 +79,5 @@ func (widget *Widget) rowColor(idx int) string {
 }
 
 func (widget *Widget) title(project *GerritProject) string {
-	return fmt.Sprintf("[green]%s [white]", project.Path)
+	return fmt.Sprintf("[%s]%s [-]", widget.settings.Colors.TextTheme.Title, project.Path)
 }
@@ -1,13 +1,12 @@
 package gerrit
 
 import (
-	"crypto/tls"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	glb "github.com/andygrunwald/go-gerrit"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -48,14 +47,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, _ *tview.Pages
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: !widget.settings.verifyServerCertificate,
-			},
-			Proxy: http.ProxyFromEnvironment,
-		},
-	}
+	httpClient := httpclient.NewClient(widget.settings.verifyServerCertificate)
 
 	gerritUrl := widget.settings.domain
 	submatches := GerritURLPattern.FindAllStringSubmatch(widget.settings.domain, -1)
@@ -72,18 +64,21 @@ func (widget *Widget) Refresh() {
 	}
 	gerrit, err := glb.NewClient(gerritUrl, httpClient)
 	if err != nil {
-		widget.err = err
 		widget.gerrit = nil
 		widget.GerritProjects = nil
 	} else {
-		widget.err = nil
 		widget.gerrit = gerrit
 		widget.GerritProjects = widget.buildProjectCollection(widget.settings.projects)
 		for _, project := range widget.GerritProjects {
-			project.Refresh(widget.settings.username)
+			if projectErr := project.Refresh(widget.settings.username); projectErr != nil && err == nil {
+				err = projectErr
+			}
 		}
 	}
 
+	widget.err = err
+	widget.SetRefreshError(err)
+
 	widget.display()
 }
 
@@ -35,7 +35,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		branchInTitle:    ymlConfig.UBool("branchInTitle", false),
 		showFilesIfEmpty: ymlConfig.UBool("showFilesIfEmpty", true),
 		lastFolderTitle:  ymlConfig.UBool("lastFolderTitle", false),
-		commitFormat:     ymlConfig.UString("commitFormat", "[forestgreen]%h [white]%s [grey]%an on %cd[white]"),
+		commitFormat:     ymlConfig.UString("commitFormat", "[forestgreen]%h [-]%s [grey]%an on %cd[-]"),
 		dateFormat:       ymlConfig.UString("dateFormat", "%b %d, %Y"),
 		repositories:     ymlConfig.UList("repositories"),
 	}
@@ -35,19 +35,19 @@ func (widget *Widget) content() (string, string, bool) {
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.GithubRepos), widget.Idx, width)
 	if widget.settings.showStats {
-		str += fmt.Sprintf("\n [%s]Stats[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]Stats[-]\n", widget.settings.Colors.Subheading)
 		str += widget.displayStats(repo)
 	}
 	if widget.settings.showOpenReviewRequests {
-		str += fmt.Sprintf("\n [%s]Open Review Requests[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]Open Review Requests[-]\n", widget.settings.Colors.Subheading)
 		str += widget.displayMyReviewRequests(repo, username)
 	}
 	if widget.settings.showMyPullRequests {
-		str += fmt.Sprintf("\n [%s]My Pull Requests[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]My Pull Requests[-]\n", widget.settings.Colors.Subheading)
 		str += widget.displayMyPullRequests(repo, username)
 	}
 	for _, customQuery := range widget.settings.customQueries {
-		str += fmt.Sprintf("\n [%s]%s[white]\n", widget.settings.Colors.Subheading, customQuery.title)
+		str += fmt.Sprintf("\n [%s]%s[-]\n", widget.settings.Colors.Subheading, customQuery.title)
 		str += widget.displayCustomQuery(repo, customQuery.filter, customQuery.perPage)
 	}
 
@@ -60,14 +60,14 @@ func (widget *Widget) displayMyPullRequests(repo *Repo, username string) string
 	prLength := len(prs)
 
 	if prLength == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, pr := range prs {
-		str += fmt.Sprintf(` %s[green]["%d"]%4d[""][white] %s`, widget.mergeString(pr), maxItems+idx, *pr.Number, *pr.Title)
+		str += fmt.Sprintf(` %s[%s]["%d"]%4d[""][-] %s`, widget.mergeString(pr), widget.settings.Colors.RoleTheme.Accent, maxItems+idx, *pr.Number, *pr.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *pr.Number)
 	}
@@ -81,20 +81,20 @@ func (widget *Widget) displayCustomQuery(repo *Repo, filter string, perPage int)
 	res := repo.customIssueQuery(filter, perPage)
 
 	if res == nil {
-		return " [grey]Invalid Query[white]\n"
+		return " [grey]Invalid Query[-]\n"
 	}
 
 	issuesLength := len(res.Issues)
 
 	if issuesLength == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, issue := range res.Issues {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, *issue.Number, *issue.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.Colors.RoleTheme.Accent, maxItems+idx, *issue.Number, *issue.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *issue.Number)
 	}
@@ -108,12 +108,12 @@ func (widget *Widget) displayMyReviewRequests(repo *Repo, username string) strin
 	prs := repo.myReviewRequests(username)
 
 	if len(prs) == 0 {
-		return " [grey]none[white]\n"
+		return " [grey]none[-]\n"
 	}
 
 	str := ""
 	for idx, pr := range prs {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, idx, *pr.Number, *pr.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.Colors.RoleTheme.Accent, idx, *pr.Number, *pr.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *pr.Number)
 	}
@@ -139,7 +139,7 @@ func (widget *Widget) displayStats(repo *Repo) string {
 
 func (widget *Widget) title(repo *Repo) string {
 	return fmt.Sprintf(
-		"[%s]%s - %s[white]",
+		"[%s]%s - %s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		repo.Owner,
 		repo.Name,
@@ -147,18 +147,28 @@ func (widget *Widget) title(repo *Repo) string {
 }
 
 var mergeIcons = map[string]string{
-	"dirty":    "[red]\u0021[white] ",
-	"clean":    "[green]\u2713[white] ",
-	"unstable": "[red]\u2717[white] ",
-	"blocked":  "[red]\u2717[white] ",
+	"dirty":    "\u0021",
+	"clean":    "\u2713",
+	"unstable": "\u2717",
+	"blocked":  "\u2717",
 }
 
 func (widget *Widget) mergeString(pr *ghb.PullRequest) string {
 	if !widget.settings.enableStatus {
 		return ""
 	}
-	if str, ok := mergeIcons[pr.GetMergeableState()]; ok {
-		return str
+
+	state := pr.GetMergeableState()
+
+	icon, ok := mergeIcons[state]
+	if !ok {
+		return "? "
 	}
-	return "? "
+
+	color := widget.settings.Colors.RoleTheme.Error
+	if state == "clean" {
+		color = widget.settings.Colors.RoleTheme.Ok
+	}
+
+	return fmt.Sprintf("[%s]%s[-] ", color, icon)
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	ghb "github.com/google/go-github/v32/github"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"golang.org/x/oauth2"
 )
@@ -114,7 +115,10 @@ func (repo *Repo) oauthClient() *http.Client {
 		&oauth2.Token{AccessToken: repo.apiKey},
 	)
 
-	return oauth2.NewClient(context.Background(), tokenService)
+	// The oauth2 client sends its requests through the client in the context
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpclient.Client())
+
+	return oauth2.NewClient(ctx, tokenService)
 }
 
 func (repo *Repo) githubClient() (*ghb.Client, error) {
@@ -94,9 +94,15 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the github data via the Github API and reruns the display
 func (widget *Widget) Refresh() {
+	var err error
 	for _, repo := range widget.GithubRepos {
 		repo.Refresh()
+
+		if repo.Err != nil && err == nil {
+			err = repo.Err
+		}
 	}
+	widget.SetRefreshError(err)
 
 	widget.display()
 }
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"github.com/wtfutil/wtf/httpclient"
 	glb "github.com/xanzy/go-gitlab"
 )
 
@@ -11,7 +12,11 @@ type context struct {
 
 func newContext(settings *Settings) (*context, error) {
 	baseURL := settings.domain
-	gitlabClient, _ := glb.NewClient(settings.apiKey, glb.WithBaseURL(baseURL))
+	gitlabClient, _ := glb.NewClient(
+		settings.apiKey,
+		glb.WithBaseURL(baseURL),
+		glb.WithHTTPClient(httpclient.Client()),
+	)
 
 	user, _, err := gitlabClient.Users.CurrentUser()
 
@@ -49,13 +54,23 @@ func NewGitlabProject(context *context, projectPath string) *GitlabProject {
 }
 
 // Refresh reloads the gitlab data via the Gitlab API
-func (project *GitlabProject) Refresh() {
-	project.MergeRequests, _ = project.loadMergeRequests()
-	project.AssignedMergeRequests, _ = project.loadAssignedMergeRequests()
-	project.AuthoredMergeRequests, _ = project.loadAuthoredMergeRequests()
-	project.AssignedIssues, _ = project.loadAssignedIssues()
-	project.AuthoredIssues, _ = project.loadAuthoredIssues()
-	project.RemoteProject, _ = project.loadRemoteProject()
+func (project *GitlabProject) Refresh() error {
+	var mrErr, assignedMRErr, authoredMRErr, assignedIssueErr, authoredIssueErr, remoteErr error
+
+	project.MergeRequests, mrErr = project.loadMergeRequests()
+	project.AssignedMergeRequests, assignedMRErr = project.loadAssignedMergeRequests()
+	project.AuthoredMergeRequests, authoredMRErr = project.loadAuthoredMergeRequests()
+	project.AssignedIssues, assignedIssueErr = project.loadAssignedIssues()
+	project.AuthoredIssues, authoredIssueErr = project.loadAuthoredIssues()
+	project.RemoteProject, remoteErr = project.loadRemoteProject()
+
+	for _, err := range []error{mrErr, assignedMRErr, authoredMRErr, assignedIssueErr, authoredIssueErr, remoteErr} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 /* -------------------- Counts -------------------- */
@@ -166,7 +181,7 @@ func (project *GitlabProject) loadAssignedIssues() ([]*glb.Issue, error) {
 	return issues, nil
 }
 
-func (project *GitlabProject) loadAuthoredIssues() ([]*glb.Issue, interface{}) {
+func (project *GitlabProject) loadAuthoredIssues() ([]*glb.Issue, error) {
 	state := "opened"
 	opts := glb.ListProjectIssuesOptions{
 		State:    &state,
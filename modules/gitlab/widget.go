@@ -57,13 +57,18 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 func (widget *Widget) Refresh() {
 	if widget.context == nil || widget.configError != nil {
+		widget.SetRefreshError(widget.configError)
 		widget.displayError()
 		return
 	}
 
+	var err error
 	for _, project := range widget.GitlabProjects {
-		project.Refresh()
+		if projectErr := project.Refresh(); projectErr != nil && err == nil {
+			err = projectErr
+		}
 	}
+	widget.SetRefreshError(err)
 
 	widget.display()
 }
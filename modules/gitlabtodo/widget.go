@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	gitlab "github.com/xanzy/go-gitlab"
@@ -25,7 +26,11 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		settings: settings,
 	}
 
-	widget.gitlabClient, _ = gitlab.NewClient(settings.apiKey, gitlab.WithBaseURL(settings.domain))
+	widget.gitlabClient, _ = gitlab.NewClient(
+		settings.apiKey,
+		gitlab.WithBaseURL(settings.domain),
+		gitlab.WithHTTPClient(httpclient.Client()),
+	)
 
 	widget.SetRenderFunction(widget.Render)
 	widget.initializeKeyboardControls()
@@ -43,6 +48,7 @@ func (widget *Widget) Refresh() {
 	todos, err := widget.getTodos()
 	widget.todos = todos
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.SetItemCount(len(todos))
 
 	widget.Render()
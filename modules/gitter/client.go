@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -61,7 +62,7 @@ func apiRequest(path, apiToken string) (*http.Response, error) {
 	bearer := fmt.Sprintf("Bearer %s", apiToken)
 	req.Header.Add("Authorization", bearer)
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
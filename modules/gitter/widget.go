@@ -38,6 +38,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	room, err := GetRoom(widget.settings.roomURI, widget.settings.apiToken)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, err.Error(), true })
 		return
@@ -49,6 +50,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	messages, err := GetMessages(room.ID, widget.settings.numberOfMessages, widget.settings.apiToken)
+	widget.SetRefreshError(err)
 
 	if err != nil {
 		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, err.Error(), true })
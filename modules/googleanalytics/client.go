@@ -3,7 +3,6 @@ package googleanalytics
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -22,69 +21,52 @@ type websiteReport struct {
 	RealtimeReport *gaV3.RealtimeData
 }
 
-func (widget *Widget) fetch() []websiteReport {
+func (widget *Widget) fetch() ([]websiteReport, error) {
 	secretPath, err := utils.ExpandHomeDir(widget.settings.secretFile)
 	if err != nil {
-		log.Fatalf("Unable to parse secretFile path")
+		return nil, fmt.Errorf("unable to parse secretFile path: %w", err)
 	}
 
-	serviceV4, err := makeReportServiceV4(secretPath)
+	client, err := buildNetClient(secretPath)
 	if err != nil {
-		log.Fatalf("Unable to create v3 Google Analytics Reporting Service")
+		return nil, err
+	}
+
+	serviceV4, err := gaV4.NewService(context.Background(), option.WithHTTPClient(client))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create v4 Google Analytics Reporting Service: %w", err)
 	}
 
 	var serviceV3 *gaV3.Service
 	if widget.settings.enableRealtime {
-		serviceV3, err = makeReportServiceV3(secretPath)
+		serviceV3, err = gaV3.NewService(context.Background(), option.WithHTTPClient(client))
 		if err != nil {
-			log.Fatalf("Unable to create v3 Google Analytics Reporting Service")
+			return nil, fmt.Errorf("unable to create v3 Google Analytics Reporting Service: %w", err)
 		}
 	}
 
-	visitorsDataArray := getReports(
+	return getReports(
 		serviceV4, widget.settings.viewIds, widget.settings.months, serviceV3,
 	)
-	return visitorsDataArray
 }
 
-func buildNetClient(secretPath string) *http.Client {
+func buildNetClient(secretPath string) (*http.Client, error) {
 	clientSecret, err := os.ReadFile(filepath.Clean(secretPath))
 	if err != nil {
-		log.Fatalf("Unable to read secretPath. %v", err)
+		return nil, fmt.Errorf("unable to read secretFile: %w", err)
 	}
 
 	jwtConfig, err := google.JWTConfigFromJSON(clientSecret, gaV4.AnalyticsReadonlyScope)
 	if err != nil {
-		log.Fatalf("Unable to get config from JSON. %v", err)
+		return nil, fmt.Errorf("unable to get config from JSON: %w", err)
 	}
 
-	return jwtConfig.Client(context.Background())
-}
-
-func makeReportServiceV3(secretPath string) (*gaV3.Service, error) {
-	client := buildNetClient(secretPath)
-
-	svc, err := gaV3.NewService(context.Background(), option.WithHTTPClient(client))
-	if err != nil {
-		log.Fatalf("Failed to create v3 Google Analytics Reporting Service")
-	}
-
-	return svc, err
-}
-
-func makeReportServiceV4(secretPath string) (*gaV4.Service, error) {
-	client := buildNetClient(secretPath)
-	svc, err := gaV4.NewService(context.Background(), option.WithHTTPClient(client))
-	if err != nil {
-		log.Fatalf("Failed to create v4 Google Analytics Reporting Service")
-	}
-
-	return svc, err
+	return jwtConfig.Client(context.Background()), nil
 }
 
 func getReports(
 	serviceV4 *gaV4.Service, viewIds map[string]interface{}, displayedMonths int, serviceV3 *gaV3.Service,
-) []websiteReport {
+) ([]websiteReport, error) {
 	startDate := fmt.Sprintf("%s-01", time.Now().AddDate(0, -displayedMonths+1, 0).Format("2006-01"))
 	var websiteReports []websiteReport
 
@@ -108,28 +90,30 @@ func getReports(
 			},
 		}
 		response, err := serviceV4.Reports.BatchGet(req).Do()
-
 		if err != nil {
-			log.Fatalf("GET request to analyticsreporting/v4 returned error with viewID: %s", viewID)
+			return nil, fmt.Errorf("GET request to analyticsreporting/v4 returned error with viewID %s: %w", viewID, err)
 		}
 		if response.HTTPStatusCode != 200 {
-			log.Fatalf("Did not get expected HTTP response code")
+			return nil, fmt.Errorf("GET request to analyticsreporting/v4 returned HTTP %d with viewID %s", response.HTTPStatusCode, viewID)
 		}
 
 		report := websiteReport{Name: website, Report: response}
 		if serviceV3 != nil {
-			report.RealtimeReport = getLiveCount(serviceV3, viewID.(string))
+			report.RealtimeReport, err = getLiveCount(serviceV3, viewID.(string))
+			if err != nil {
+				return nil, err
+			}
 		}
 		websiteReports = append(websiteReports, report)
 	}
-	return websiteReports
+	return websiteReports, nil
 }
 
-func getLiveCount(service *gaV3.Service, viewID string) *gaV3.RealtimeData {
+func getLiveCount(service *gaV3.Service, viewID string) (*gaV3.RealtimeData, error) {
 	res, err := service.Data.Realtime.Get("ga:"+viewID, "rt:activeUsers").Do()
 	if err != nil {
-		log.Fatalf("Failed to fetch real time data for view ID %s: %v.  Have you enrolled in the real time beta?  If not, do so here: https://docs.google.com/forms/d/1qfRFysCikpgCMGqgF3yXdUyQW4xAlLyjKuOoOEFN2Uw/viewform", viewID, err)
+		return nil, fmt.Errorf("failed to fetch real time data for view ID %s: %w. Have you enrolled in the real time beta? If not, do so here: https://docs.google.com/forms/d/1qfRFysCikpgCMGqgF3yXdUyQW4xAlLyjKuOoOEFN2Uw/viewform", viewID, err)
 	}
 
-	return res
+	return res, nil
 }
@@ -30,7 +30,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		enableRealtime: ymlConfig.UBool("enableRealtime", false),
 	}
 
-	settings.SetDocumentationPath("google/analytics")
-
 	return &settings
 }
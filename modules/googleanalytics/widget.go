@@ -22,7 +22,14 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 }
 
 func (widget *Widget) Refresh() {
-	websiteReports := widget.fetch()
+	websiteReports, err := widget.fetch()
+	widget.SetRefreshError(err)
+
+	if err != nil {
+		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, err.Error(), true })
+		return
+	}
+
 	contentTable := widget.createTable(websiteReports)
 
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, contentTable, false })
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"sort"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -76,6 +77,10 @@ func NewClient(settings *Settings) *Client {
 }
 
 func (client *Client) Alerts() ([]Alert, error) {
+	if client.baseURI == "" {
+		return nil, errors.New("baseUri for grafana is empty, but is required")
+	}
+
 	// query the alerts API of Grafana https://grafana.com/docs/grafana/latest/http_api/alerting/
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/alerts", client.baseURI), http.NoBody)
 	if err != nil {
@@ -86,7 +91,7 @@ func (client *Client) Alerts() ([]Alert, error) {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", client.apiKey))
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpclient.Client().Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,10 @@
 package grafana
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/cfg"
+)
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
@@ -12,7 +16,7 @@ func (widget *Widget) content() (string, string, bool) {
 		for idx, alert := range widget.Alerts {
 			out += fmt.Sprintf(` ["%d"][%s]%s - %s[""]`,
 				idx,
-				stateColor(alert.State),
+				stateColor(alert.State, widget.settings.Colors.RoleTheme),
 				stateToEmoji(alert.State),
 				alert.Name,
 			)
@@ -23,20 +27,20 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, out, false
 }
 
-func stateColor(state AlertState) string {
+func stateColor(state AlertState, roles cfg.RoleTheme) string {
 	switch state {
 	case Ok:
-		return "green"
+		return roles.Ok
 	case Paused:
-		return "yellow"
+		return roles.Warn
 	case Alerting:
-		return "red"
+		return roles.Error
 	case Pending:
 		return "orange"
 	case NoData:
-		return "yellow"
+		return roles.Warn
 	default:
-		return "white"
+		return "-"
 	}
 }
 
@@ -1,7 +1,6 @@
 package grafana
 
 import (
-	"log"
 	"os"
 	"strings"
 
@@ -18,7 +17,7 @@ type Settings struct {
 	*cfg.Common
 
 	apiKey  string `help:"Your Grafana API token."`
-	baseURI string `help:"Base url of your grafana instance"`
+	baseURI string `help:"Base url of your grafana instance" optional:"false"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -30,9 +29,6 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		baseURI: ymlConfig.UString("baseUri", ""),
 	}
 
-	if settings.baseURI == "" {
-		log.Fatal("baseUri for grafana is empty, but is required")
-	}
 	settings.baseURI = strings.TrimSuffix(settings.baseURI, "/")
 
 	return &settings
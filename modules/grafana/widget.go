@@ -40,6 +40,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, _ *tview.Pages
 
 func (widget *Widget) Refresh() {
 	alerts, err := widget.Client.Alerts()
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.Err = err
 		widget.Alerts = nil
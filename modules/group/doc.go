@@ -0,0 +1,22 @@
+/*
+Package group displays several modules, of any type, in one grid cell, one at a time, with
+a tab for each of them in the title.
+
+	builds:
+	  type: group
+	  rotateInterval: 30s
+	  mods:
+	    github:
+	      repositories: [wtfutil/wtf]
+	    circleci:
+	      priority: 1
+	    incidents:
+	      type: pagerduty
+	  position: ...
+
+The modules in mods are configured the same way as in wtf.mods, except that they're
+enabled unless they say otherwise and don't need positions. Each of them refreshes on its
+own refresh interval. "]" and "[" switch between the tabs, every other key goes to the
+module that's displayed.
+*/
+package group
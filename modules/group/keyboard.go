@@ -0,0 +1,41 @@
+package group
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("]", widget.NextTab, "Select next tab")
+	widget.SetKeyboardChar("[", widget.PrevTab, "Select previous tab")
+}
+
+// inputCapture handles the group's own keys, and passes every other key to the module
+// that's displayed, as if it had focus
+func (widget *Widget) inputCapture(event *tcell.EventKey) *tcell.EventKey {
+	event = widget.KeyboardWidget.InputCapture(event)
+	if event == nil {
+		return nil
+	}
+
+	current := widget.current()
+	if current == nil {
+		return event
+	}
+
+	view := current.TextView()
+
+	if capture := view.GetInputCapture(); capture != nil {
+		event = capture(event)
+		if event == nil {
+			return nil
+		}
+	}
+
+	view.InputHandler()(event, func(p tview.Primitive) { widget.app.SetFocus(p) })
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package group
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "Group"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	*cfg.Common
+
+	mods           map[string]interface{} `help:"The modules to display, one tab each, configured the same way as in wtf.mods. Tabs are ordered by priority, then by name." optional:"false"`
+	rotateInterval time.Duration          `help:"How often to switch to the next tab, such as \"30s\". Tabs aren't switched while the group has focus." optional:"true"`
+
+	children []child
+
+	// The dimensions of the module
+	width  int
+	height int
+}
+
+// child is one of the modules displayed in the group
+type child struct {
+	definition wtf.ModuleDefinition
+	name       string
+	settings   interface{}
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, moduleConfig, globalConfig),
+
+		mods:           moduleConfig.UMap("mods"),
+		rotateInterval: cfg.ParseTimeString(moduleConfig, "rotateInterval", "0"),
+	}
+
+	settings.children = newChildren(name, moduleConfig, globalConfig)
+
+	width, height, err := utils.CalculateDimensions(moduleConfig, globalConfig)
+	if err == nil {
+		settings.width = width
+		settings.height = height
+	}
+
+	return &settings
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// SetScope sets where the group is configured, and puts the modules in it in a scope of
+// their own, so that they're kept apart from the modules outside the group
+func (settings *Settings) SetScope(scope string) {
+	settings.Common.SetScope(scope)
+
+	for _, child := range settings.children {
+		if scoped, ok := child.settings.(interface{ SetScope(string) }); ok {
+			scoped.SetScope(scope + "/" + settings.Name)
+		}
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// newChildren creates the settings of the modules in the group, in tab order. They're
+// enabled unless they say otherwise, and share the group's position, so that modules
+// which size their content to their widget get the group's size
+func newChildren(name string, moduleConfig *config.Config, globalConfig *config.Config) []child {
+	children := []child{}
+	priorities := map[string]int{}
+
+	for childName := range moduleConfig.UMap("mods") {
+		values, err := moduleConfig.Map("mods." + childName)
+		if err != nil {
+			continue
+		}
+
+		// The defaults go in a copy, so that the loaded config stays as it was written
+		root := map[string]interface{}{"enabled": true}
+		if position, err := moduleConfig.Map("position"); err == nil {
+			root["position"] = position
+		}
+		for key, value := range values {
+			root[key] = value
+		}
+		childConfig := &config.Config{Root: root}
+
+		if !childConfig.UBool("enabled", true) {
+			continue
+		}
+
+		moduleType := childConfig.UString("type", childName)
+
+		definition, ok := wtf.LookupModule(moduleType)
+		if !ok {
+			logger.Module(name).Warn(fmt.Sprintf("%s is not displayed, %s is not a known type of module", childName, moduleType))
+			continue
+		}
+
+		settings := definition.NewSettings(childName, childConfig, globalConfig)
+		if documented, ok := settings.(interface{ SetDocumentationPath(string) }); ok && definition.DocPath != "" {
+			documented.SetDocumentationPath(definition.DocPath)
+		}
+
+		priorities[childName] = childConfig.UInt("priority", 0)
+		children = append(children, child{definition: definition, name: childName, settings: settings})
+	}
+
+	sort.SliceStable(children, func(i, j int) bool {
+		a, b := children[i].name, children[j].name
+		if priorities[a] != priorities[b] {
+			return priorities[a] > priorities[b]
+		}
+		return a < b
+	})
+
+	return children
+}
@@ -0,0 +1,278 @@
+package group
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// Widget is the container for the modules in the group
+type Widget struct {
+	view.TextWidget
+
+	app      *tview.Application
+	children []wtf.Wtfable
+	settings *Settings
+
+	m   sync.Mutex
+	idx int
+
+	// The size the modules were last told they have
+	height int
+	width  int
+
+	// The modules' redraws come through here, so that the tabs can be updated first
+	redrawChan chan bool
+}
+
+// NewWidget creates and returns an instance of Widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		app:        tviewApp,
+		children:   []wtf.Wtfable{},
+		settings:   settings,
+		redrawChan: make(chan bool),
+	}
+
+	for _, child := range settings.children {
+		childWidget := child.definition.NewWidget(tviewApp, widget.redrawChan, pages, child.settings)
+		if childWidget == nil {
+			continue
+		}
+
+		// The group draws the border, the modules fill the space inside it
+		childWidget.TextView().SetBorder(false)
+
+		widget.children = append(widget.children, childWidget)
+	}
+
+	// The modules were created with the group's position, so they already have its size
+	widget.width, widget.height = settings.width, settings.height
+
+	widget.initializeKeyboardControls()
+	widget.SetHelpTextFunc(widget.HelpText)
+
+	widget.View.SetInputCapture(widget.inputCapture)
+	widget.View.SetMouseCapture(widget.mouseCapture)
+	widget.View.SetDrawFunc(widget.draw)
+
+	widget.display()
+
+	go widget.forwardRedraws()
+
+	if settings.rotateInterval > 0 {
+		go widget.rotate()
+	}
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Children returns the modules in the group, so that the app schedules their refreshes
+func (widget *Widget) Children() []wtf.Wtfable {
+	return widget.children
+}
+
+// HelpText returns the group's keyboard commands followed by those of the module that's
+// displayed
+func (widget *Widget) HelpText() string {
+	current := widget.current()
+	if current == nil {
+		return widget.KeyboardWidget.HelpText()
+	}
+
+	return fmt.Sprintf("%s\n %s\n\n%s", widget.KeyboardWidget.HelpText(), widget.tabTitle(current), current.HelpText())
+}
+
+// NextTab displays the next module in the group, wrapping around to the first
+func (widget *Widget) NextTab() {
+	widget.selectTab(1)
+}
+
+// Pause stops the modules in the group from refreshing, as well as the group's rotation
+func (widget *Widget) Pause() {
+	widget.TextWidget.Pause()
+
+	for _, child := range widget.children {
+		child.Pause()
+	}
+}
+
+// PrevTab displays the previous module in the group, wrapping around to the last
+func (widget *Widget) PrevTab() {
+	widget.selectTab(-1)
+}
+
+// Refresh refreshes every module in the group
+func (widget *Widget) Refresh() {
+	for _, child := range widget.children {
+		go child.Refresh()
+	}
+}
+
+// Resume lets the modules in the group refresh again
+func (widget *Widget) Resume() {
+	widget.TextWidget.Resume()
+
+	for _, child := range widget.children {
+		child.Resume()
+	}
+}
+
+// Stop stops the group along with the modules in it
+func (widget *Widget) Stop() {
+	widget.TextWidget.Stop()
+
+	for _, child := range widget.children {
+		child.Stop()
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) current() wtf.Wtfable {
+	widget.m.Lock()
+	defer widget.m.Unlock()
+
+	if len(widget.children) == 0 {
+		return nil
+	}
+
+	return widget.children[widget.idx]
+}
+
+// display updates the tabs in the title
+func (widget *Widget) display() {
+	current := widget.current()
+
+	tabs := []string{}
+	for _, child := range widget.children {
+		if child == current {
+			tabs = append(tabs, fmt.Sprintf("[::b]%s[::-]", widget.tabTitle(child)))
+		} else {
+			tabs = append(tabs, fmt.Sprintf("[::d]%s[::-]", widget.tabTitle(child)))
+		}
+	}
+
+	title := strings.Join(tabs, " | ")
+	if len(tabs) == 0 {
+		title = widget.CommonSettings().Title
+	}
+
+	widget.View.SetTitle(widget.ContextualTitle(title))
+}
+
+// draw draws the module that's displayed inside the group's border, telling the modules
+// when the group has changed size
+func (widget *Widget) draw(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+	if widget.Bordered() {
+		x, y, width, height = x+1, y+1, width-2, height-2
+	}
+
+	if width != widget.width || height != widget.height {
+		widget.width, widget.height = width, height
+		widget.resize(width, height)
+	}
+
+	if current := widget.current(); current != nil {
+		childView := current.TextView()
+		childView.SetRect(x, y, width, height)
+		childView.Draw(screen)
+	}
+
+	return x, y, width, height
+}
+
+// forwardRedraws updates the tabs, whose titles come from the modules, whenever one of
+// the modules redraws, and then redraws the screen
+func (widget *Widget) forwardRedraws() {
+	for data := range widget.redrawChan {
+		widget.display()
+		widget.RedrawChan <- data
+	}
+}
+
+// mouseCapture passes clicks and scrolling inside the border to the module that's
+// displayed. Anything that would focus the module's view focuses the group instead
+func (widget *Widget) mouseCapture(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	current := widget.current()
+	if current == nil {
+		return action, event
+	}
+
+	consumed, _ := current.TextView().MouseHandler()(action, event, func(tview.Primitive) {
+		widget.app.SetFocus(widget.View)
+	})
+	if !consumed {
+		return action, event
+	}
+
+	return action, nil
+}
+
+// resize tells the modules that render for their size what the group's new size is.
+// They usually refresh when they're resized, so it's done off the tview goroutine
+func (widget *Widget) resize(width, height int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	for _, child := range widget.children {
+		if resizable, ok := child.(wtf.Resizable); ok {
+			go resizable.Resize(width, height)
+		}
+	}
+}
+
+// rotate switches to the next tab on the rotation interval, unless the group has focus
+func (widget *Widget) rotate() {
+	ticker := time.NewTicker(widget.settings.rotateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if widget.Disabled() {
+				return
+			}
+
+			if widget.Paused() || widget.View.HasFocus() {
+				continue
+			}
+
+			widget.QueueUpdateDraw(widget.NextTab)
+		case quit := <-widget.QuitChan():
+			if quit {
+				return
+			}
+		}
+	}
+}
+
+func (widget *Widget) selectTab(offset int) {
+	widget.m.Lock()
+	if len(widget.children) > 0 {
+		widget.idx = (widget.idx + offset + len(widget.children)) % len(widget.children)
+	}
+	widget.m.Unlock()
+
+	widget.display()
+}
+
+// tabTitle returns the title of a module's tab, which is the title it's displaying
+func (widget *Widget) tabTitle(child wtf.Wtfable) string {
+	if title := strings.TrimSpace(child.TextView().GetTitle()); title != "" {
+		return title
+	}
+
+	return child.CommonSettings().Title
+}
@@ -0,0 +1,97 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/modules/clocks"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const groupConfig = `
+wtf:
+  mods:
+    times:
+      type: group
+      mods:
+        utc:
+          type: clocks
+          title: UTC
+        local:
+          type: clocks
+          title: Local
+        first:
+          type: clocks
+          priority: 1
+          title: First
+        hidden:
+          type: clocks
+          enabled: false
+        missing:
+          type: nonexistent
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`
+
+func newTestWidget(t *testing.T) *Widget {
+	globalConfig, err := config.ParseYaml(groupConfig)
+	assert.NoError(t, err)
+
+	moduleConfig, _ := globalConfig.Get("wtf.mods.times")
+	settings := NewSettingsFromYAML("times", moduleConfig, globalConfig)
+
+	return NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+}
+
+func Test_NewSettingsFromYAML(t *testing.T) {
+	globalConfig, _ := config.ParseYaml(groupConfig)
+	moduleConfig, _ := globalConfig.Get("wtf.mods.times")
+
+	settings := NewSettingsFromYAML("times", moduleConfig, globalConfig)
+
+	names := []string{}
+	for _, child := range settings.children {
+		names = append(names, child.name)
+	}
+
+	assert.Equal(t, []string{"first", "local", "utc"}, names)
+
+	// The loaded config isn't changed by the defaults the modules are given
+	_, err := globalConfig.Get("wtf.mods.times.mods.utc.enabled")
+	assert.Error(t, err)
+
+	// The modules in the group are scoped to it
+	settings.SetScope("/config.yml")
+	assert.Equal(t, "/config.yml", settings.Scope)
+
+	for _, child := range settings.children {
+		assert.Equal(t, "/config.yml/times", child.settings.(*clocks.Settings).Scope)
+	}
+}
+
+func Test_Tabs(t *testing.T) {
+	widget := newTestWidget(t)
+	defer widget.Stop()
+
+	assert.Implements(t, (*wtf.Container)(nil), widget)
+	assert.Equal(t, 3, len(widget.Children()))
+
+	for _, child := range widget.Children() {
+		assert.True(t, child.Enabled())
+	}
+
+	assert.Equal(t, "first", widget.current().Name())
+	assert.Contains(t, widget.View.GetTitle(), "[::b]First[::-] | [::d]Local[::-] | [::d]UTC[::-]")
+
+	widget.NextTab()
+	assert.Equal(t, "local", widget.current().Name())
+	assert.Contains(t, widget.View.GetTitle(), "[::d]First[::-] | [::b]Local[::-]")
+
+	widget.PrevTab()
+	widget.PrevTab()
+	assert.Equal(t, "utc", widget.current().Name())
+}
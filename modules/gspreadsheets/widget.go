@@ -32,6 +32,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 func (widget *Widget) Refresh() {
 	cells, err := widget.Fetch()
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.cells = cells
 
 	widget.Redraw(widget.content)
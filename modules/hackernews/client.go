@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -58,7 +59,7 @@ func apiRequest(path string) ([]byte, error) {
 		return nil, err
 	}
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
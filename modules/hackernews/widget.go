@@ -39,6 +39,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	storyIds, err := GetStories(widget.settings.storyType)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.stories = nil
@@ -81,7 +82,7 @@ func (widget *Widget) content() (string, string, bool) {
 		u, _ := url.Parse(story.URL)
 
 		row := fmt.Sprintf(
-			`[%s]%2d. %s [lightblue](%s)[white]`,
+			`[%s]%2d. %s [lightblue](%s)[-]`,
 			widget.RowColor(idx),
 			idx+1,
 			story.Title,
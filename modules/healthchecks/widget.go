@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -68,6 +69,7 @@ func (widget *Widget) Refresh() {
 	checks, err := widget.getExistingChecks()
 	widget.checks = checks
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.SetItemCount(len(checks))
 	widget.Render()
 }
@@ -105,21 +107,24 @@ func (widget *Widget) content() (string, string, bool) {
 func (widget *Widget) contentFrom(checks []Checks) string {
 	var str string
 
+	roles := widget.settings.Colors.RoleTheme
+
 	for _, check := range checks {
 		prefix := ""
 
 		switch check.Status {
 		case "up":
-			prefix += "[green] + "
+			prefix += fmt.Sprintf("[%s] + ", roles.Ok)
 		case "down":
-			prefix += "[red] - "
+			prefix += fmt.Sprintf("[%s] - ", roles.Error)
 		default:
-			prefix += "[yellow] ~ "
+			prefix += fmt.Sprintf("[%s] ~ ", roles.Warn)
 		}
 
-		str += fmt.Sprintf(`%s%s [gray](%s|%d)[white]%s`,
+		str += fmt.Sprintf(`%s%s [%s](%s|%d)[-]%s`,
 			prefix,
 			check.Name,
+			roles.Muted,
 			timeSincePing(check.LastPing),
 			check.NPings,
 			"\n",
@@ -163,7 +168,7 @@ func (widget *Widget) getExistingChecks() ([]Checks, error) {
 	}
 	req.Header.Set("User-Agent", userAgent)
 	req.Header.Set("X-Api-Key", widget.settings.apiKey)
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpclient.Client().Do(req)
 
 	if err != nil {
 		return nil, err
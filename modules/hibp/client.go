@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 const (
@@ -34,9 +36,8 @@ func (widget *Widget) fetchForAccount(account string, since string) (*Status, er
 		return nil, nil
 	}
 
-	hibpClient := http.Client{
-		Timeout: time.Second * clientTimeoutSecs,
-	}
+	hibpClient := httpclient.Client()
+	hibpClient.Timeout = time.Second * clientTimeoutSecs
 
 	asTruncated := true
 	if since != "" {
@@ -49,6 +49,7 @@ func (widget *Widget) Fetch(accounts []string) ([]*Status, error) {
 func (widget *Widget) Refresh() {
 	statuses, err := widget.Fetch(widget.settings.accounts)
 
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.statuses = nil
@@ -79,7 +80,7 @@ func (widget *Widget) content() (string, string, bool) {
 		}
 
 		if status != nil {
-			str += fmt.Sprintf(" [%s]%s[white]\n", color, status.Account)
+			str += fmt.Sprintf(" [%s]%s[-]\n", color, status.Account)
 		}
 	}
 
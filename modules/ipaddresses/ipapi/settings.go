@@ -30,7 +30,6 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 	settings.colors.name = ymlConfig.UString("colors.name", "red")
 	settings.colors.value = ymlConfig.UString("colors.value", "white")
-	settings.SetDocumentationPath("ipaddress/ipapi")
 
 	return &settings
 }
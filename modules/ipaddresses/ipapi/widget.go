@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -80,34 +81,36 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 
 // Refresh refresh the module
 func (widget *Widget) Refresh() {
-	widget.ipinfo()
+	widget.SetRefreshError(widget.ipinfo())
 
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
-// this method reads the config and calls ipinfo for ip information
-func (widget *Widget) ipinfo() {
-	client := &http.Client{}
+// this method reads the config and calls ipinfo for ip information, returning the error if it fails
+func (widget *Widget) ipinfo() error {
+	client := httpclient.Client()
 	req, err := http.NewRequest("GET", "http://ip-api.com/json?fields=66846719", http.NoBody)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 	var info ipinfo
 	err = json.NewDecoder(response.Body).Decode(&info)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	widget.setResult(&info)
+
+	return nil
 }
 
 func (widget *Widget) setResult(info *ipinfo) {
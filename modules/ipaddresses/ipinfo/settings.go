@@ -58,13 +58,10 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 	pv, err := newProtocolVersion(ymlConfig.UString("protocolVersion", auto.String()))
 	if err != nil {
-		log.Log(err.Error())
-		log.Log(fmt.Sprintf("%s module: Use '%s' protocol version as a default", defaultTitle, auto))
+		log.Module(name).Warnf("%s, using '%s' protocol version as a default", err, auto)
 	} else {
 		settings.protocolVersion = pv
 	}
 
-	settings.SetDocumentationPath("ipaddress/ipinfo")
-
 	return &settings
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/wtfutil/wtf/httpclient"
 	log "github.com/wtfutil/wtf/logger"
 	"net"
 	"net/http"
@@ -44,14 +45,14 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 }
 
 func (widget *Widget) Refresh() {
-	widget.ipinfo()
+	widget.SetRefreshError(widget.ipinfo())
 
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
-// this method reads the config and calls ipinfo for ip information
-func (widget *Widget) ipinfo() {
-	client := &http.Client{}
+// this method reads the config and calls ipinfo for ip information, returning the error if it fails
+func (widget *Widget) ipinfo() error {
+	client := httpclient.Client()
 	var url string
 	ip, ipv6 := getMyIP(widget.settings.protocolVersion)
 	if ipv6 {
@@ -63,7 +64,7 @@ func (widget *Widget) ipinfo() {
 	req, err := http.NewRequest("GET", url, http.NoBody)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 	req.Header.Set("User-Agent", "curl")
 	if widget.settings.apiToken != "" {
@@ -73,7 +74,7 @@ func (widget *Widget) ipinfo() {
 	response, err := client.Do(req)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 
@@ -81,10 +82,12 @@ func (widget *Widget) ipinfo() {
 	err = json.NewDecoder(response.Body).Decode(&info)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	widget.setResult(&info)
+
+	return nil
 }
 
 func (widget *Widget) setResult(info *ipinfo) {
@@ -129,8 +132,8 @@ func formatableText(key, value string) string {
 // The 'net' package is allowed to decide how to connect, connecting to both IPv4 or IPv6 address
 // depending on the availbility of IP protocols.
 func getMyIP(version protocolVersion) (ip net.IP, v6 bool) {
-	log.Log(fmt.Sprintf("Protocol version: %s", version))
-	log.Log(fmt.Sprintf("Network: %s", version.toNetwork()))
+	log.Module("ipinfo").Debugf("Protocol version: %s", version)
+	log.Module("ipinfo").Debugf("Network: %s", version.toNetwork())
 	//fmt.Println("Protocol version: ", version)
 	conn, err := net.Dial(version.toNetwork(), "fast.com:80")
 	if err != nil {
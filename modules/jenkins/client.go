@@ -1,12 +1,12 @@
 package jenkins
 
 import (
-	"crypto/tls"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -27,13 +27,7 @@ func (widget *Widget) Create(jenkinsURL string, username string, apiKey string)
 	req, _ := http.NewRequest("GET", jenkinsAPIURL.String(), http.NoBody)
 	req.SetBasicAuth(username, apiKey)
 
-	httpClient := &http.Client{Transport: &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: !widget.settings.verifyServerCertificate,
-		},
-		Proxy: http.ProxyFromEnvironment,
-	},
-	}
+	httpClient := httpclient.NewClient(widget.settings.verifyServerCertificate)
 	resp, err := httpClient.Do(req)
 
 	if err != nil {
@@ -3,8 +3,10 @@ package jenkins
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -42,10 +44,22 @@ func (widget *Widget) Refresh() {
 		widget.settings.user,
 		widget.settings.apiKey,
 	)
-	widget.view = view
 
+	widget.SetRefreshError(err)
+	if err != nil {
+		// Show the jobs from the last successful refresh until Jenkins can be reached again
+		cached := &View{}
+		if widget.LoadCache(cached) {
+			view, err = cached, nil
+		}
+	} else {
+		widget.SaveCache(view)
+		widget.RaiseEvents(failedJobEvents(view.Jobs))
+	}
+
+	widget.view = view
+	widget.err = err
 	if err != nil {
-		widget.err = err
 		widget.SetItemCount(0)
 	} else {
 		widget.SetItemCount(len(widget.view.Jobs))
@@ -54,6 +68,19 @@ func (widget *Widget) Refresh() {
 	widget.Render()
 }
 
+// RenderCached displays the jobs from the last successful refresh, until the first one
+func (widget *Widget) RenderCached() {
+	view := &View{}
+	if !widget.LoadCache(view) {
+		return
+	}
+
+	widget.view = view
+	widget.SetItemCount(len(view.Jobs))
+
+	widget.Render()
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) Render() {
@@ -61,7 +88,7 @@ func (widget *Widget) Render() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s: [red]%s", widget.CommonSettings().Title, widget.view.Name)
+	title := fmt.Sprintf("%s: [%s]%s", widget.CommonSettings().Title, widget.settings.Colors.RoleTheme.Accent, widget.view.Name)
 	if widget.err != nil {
 		return title, widget.err.Error(), true
 	}
@@ -75,7 +102,7 @@ func (widget *Widget) content() (string, string, bool) {
 		jobName, _ := url.QueryUnescape(job.Name)
 
 		row := fmt.Sprintf(
-			`[%s] [%s]%-6s[white]`,
+			`[%s] [%s]%-6s[-]`,
 			widget.RowColor(idx),
 			widget.jobColor(job),
 			jobName,
@@ -87,15 +114,40 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
+// failedJobEvents turns the jobs whose last build failed into events, so that new
+// failures can be alerted on
+func failedJobEvents(jobs []Job) []notify.Event {
+	events := []notify.Event{}
+
+	for _, job := range jobs {
+		if !strings.HasPrefix(job.Color, "red") {
+			continue
+		}
+
+		jobName, _ := url.QueryUnescape(job.Name)
+
+		events = append(events, notify.Event{
+			Key:      job.Url,
+			Kind:     "build-failed",
+			Message:  "The last build failed",
+			Severity: notify.SeverityError,
+			Title:    jobName,
+			URL:      job.Url,
+		})
+	}
+
+	return events
+}
+
 func (widget *Widget) jobColor(job Job) string {
 	switch job.Color {
 	case "blue":
 		// Override color if successBallColor boolean param provided in config
 		return widget.settings.successBallColor
 	case "red":
-		return "red"
+		return widget.settings.Colors.RoleTheme.Error
 	default:
-		return "white"
+		return "-"
 	}
 }
 
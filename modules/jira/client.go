@@ -2,13 +2,13 @@ package jira
 
 import (
 	"bytes"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -69,14 +69,7 @@ func (widget *Widget) jiraRequest(path string) ([]byte, error) {
 		req.SetBasicAuth(widget.settings.email, widget.settings.apiKey)
 	}
 
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: !widget.settings.verifyServerCertificate,
-			},
-			Proxy: http.ProxyFromEnvironment,
-		},
-	}
+	httpClient := httpclient.NewClient(widget.settings.verifyServerCertificate)
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -38,6 +38,7 @@ func (widget *Widget) Refresh() {
 		widget.settings.jql,
 	)
 
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.result = nil
@@ -74,23 +75,26 @@ func (widget *Widget) content() (string, string, bool) {
 
 	title := widget.CommonSettings().Title
 
-	str := fmt.Sprintf(" [%s]Assigned Issues[white]\n", widget.settings.Colors.Subheading)
+	str := fmt.Sprintf(" [%s]Assigned Issues[-]\n", widget.settings.Colors.Subheading)
 
 	if widget.result == nil || len(widget.result.Issues) == 0 {
 		return title, "No results to display", false
 	}
 
 	longestIssueTypeLength, longestKeyLength, longestStatusNameLength := getLongestColumnLengths(widget.result.Issues)
+	roles := widget.settings.Colors.RoleTheme
 
 	for idx, issue := range widget.result.Issues {
 		row := fmt.Sprintf(
-			`[%s] [%s]%-*s[white] [green]%-*s[white] [yellow]%-*s[white] [%s]%s`,
+			`[%s] [%s]%-*s[-] [%s]%-*s[-] [%s]%-*s[-] [%s]%s`,
 			widget.RowColor(idx),
 			widget.issueTypeColor(&issue),
 			longestIssueTypeLength+1,
 			trimToMaxLength(issue.IssueFields.IssueType.Name, MaxIssueTypeLength),
+			roles.Accent,
 			longestKeyLength+1,
 			issue.Key,
+			roles.Warn,
 			longestStatusNameLength+1,
 			trimToMaxLength(issue.IssueFields.IssueStatus.IName, MaxStatusNameLength),
 			widget.RowColor(idx),
@@ -135,16 +139,18 @@ func getLongestColumnLengths(issues []Issue) (int, int, int) {
 	return longestIssueTypeLength, longestKeyLength, longestStatusNameLength
 }
 
-func (*Widget) issueTypeColor(issue *Issue) string {
+func (widget *Widget) issueTypeColor(issue *Issue) string {
+	roles := widget.settings.Colors.RoleTheme
+
 	switch issue.IssueFields.IssueType.Name {
 	case "Bug":
-		return "red"
+		return roles.Error
 	case "Story":
-		return "blue"
+		return roles.Accent
 	case "Task":
 		return "orange"
 	default:
-		return "white"
+		return "-"
 	}
 }
 
@@ -1,13 +1,12 @@
 package krisinformation
 
 import (
-	"fmt"
 	"math"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 )
@@ -79,7 +78,7 @@ func NewClient(latitude, longitude float64, radius int, county string, country b
 //   - County
 //   - Region
 func (c *Client) getKrisinformation() (items []Item, err error) {
-	resp, err := http.Get(krisinformationAPI)
+	resp, err := httpclient.Client().Get(krisinformationAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +142,7 @@ func (c *Client) getKrisinformation() (items []Item, err error) {
 				}
 
 				distance := DistanceInMeters(kris_latitude, kris_longitude, c.latitude, c.longitude)
-				logger.Log(fmt.Sprintf("Distance: %f", distance/1000)) // KM
+				logger.Module("krisinformation").Debugf("Distance: %f", distance/1000) // KM
 				if distance < float64(c.radius) {
 					item := Item{
 						PushMessage: data[i].PushMessage,
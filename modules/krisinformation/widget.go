@@ -55,9 +55,7 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 	now := time.Now()
 	kriser, err := widget.client.getKrisinformation()
-	if err != nil {
-		handleError(widget, err)
-	}
+	handleError(widget, err)
 
 	var str string
 	i := 0
@@ -87,4 +85,5 @@ func (widget *Widget) display() {
 
 func handleError(widget *Widget, err error) {
 	widget.err = err
+	widget.SetRefreshError(err)
 }
@@ -18,7 +18,7 @@ type Settings struct {
 	title      string   `help:"Override the title of widget."`
 	kubeconfig string   `help:"Location of a kubeconfig file."`
 	namespaces []string `help:"List of namespaces to watch. If blank, defaults to all namespaces."`
-	context    string   `help:"Kubernetes context to use. If blank, uses default context"`
+	context    string   `help:"Kubernetes context to use. If blank, uses default context" optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -49,6 +49,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 func (widget *Widget) Refresh() {
 	title := widget.generateTitle()
 	client, err := widget.getInstance()
+	widget.SetRefreshError(err)
 
 	if err != nil {
 		widget.Redraw(func() (string, string, bool) { return title, err.Error(), true })
@@ -60,10 +61,13 @@ func (widget *Widget) Refresh() {
 	if utils.Includes(widget.objects, "nodes") {
 		nodeList, nodeError := client.getNodes()
 		if nodeError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting node data [white]\n", true })
+			widget.SetRefreshError(nodeError)
+			widget.Redraw(func() (string, string, bool) {
+				return title, fmt.Sprintf("[%s] Error getting node data [-]\n", widget.settings.Colors.RoleTheme.Error), true
+			})
 			return
 		}
-		content += fmt.Sprintf("[%s]Nodes[white]\n", widget.settings.Colors.Subheading)
+		content += fmt.Sprintf("[%s]Nodes[-]\n", widget.settings.Colors.Subheading)
 		for _, node := range nodeList {
 			content += fmt.Sprintf("%s\n", node)
 		}
@@ -73,10 +77,13 @@ func (widget *Widget) Refresh() {
 	if utils.Includes(widget.objects, "deployments") {
 		deploymentList, deploymentError := client.getDeployments(widget.namespaces)
 		if deploymentError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting deployment data [white]\n", true })
+			widget.SetRefreshError(deploymentError)
+			widget.Redraw(func() (string, string, bool) {
+				return title, fmt.Sprintf("[%s] Error getting deployment data [-]\n", widget.settings.Colors.RoleTheme.Error), true
+			})
 			return
 		}
-		content += fmt.Sprintf("[%s]Deployments[white]\n", widget.settings.Colors.Subheading)
+		content += fmt.Sprintf("[%s]Deployments[-]\n", widget.settings.Colors.Subheading)
 		for _, deployment := range deploymentList {
 			content += fmt.Sprintf("%s\n", deployment)
 		}
@@ -86,10 +93,13 @@ func (widget *Widget) Refresh() {
 	if utils.Includes(widget.objects, "pods") {
 		podList, podError := client.getPods(widget.namespaces)
 		if podError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting pod data [white]\n", false })
+			widget.SetRefreshError(podError)
+			widget.Redraw(func() (string, string, bool) {
+				return title, fmt.Sprintf("[%s] Error getting pod data [-]\n", widget.settings.Colors.RoleTheme.Error), false
+			})
 			return
 		}
-		content += fmt.Sprintf("[%s]Pods[white]\n", widget.settings.Colors.Subheading)
+		content += fmt.Sprintf("[%s]Pods[-]\n", widget.settings.Colors.Subheading)
 		for _, pod := range podList {
 			content += fmt.Sprintf("%s\n", pod)
 		}
@@ -3,21 +3,35 @@ package logger
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	log "github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
 	defaultFocusable = true
+	defaultLevel     = "debug"
 	defaultTitle     = "Logger"
 )
 
 type Settings struct {
 	*cfg.Common
+
+	level   log.Level `help:"The minimum level of the log entries to display. One of debug, info, warn, error." values:"debug, info, warn, error" optional:"true"`
+	modules []string  `help:"The modules whose log entries to display. Entries from all modules are displayed if this is empty." optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
 	settings := Settings{
 		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		modules: utils.ToStrs(ymlConfig.UList("modules")),
+	}
+
+	level, err := log.ParseLevel(ymlConfig.UString("level", defaultLevel))
+	if err != nil {
+		log.Module(name).Warnf("%s, displaying entries of level %s and above", err, level)
 	}
+	settings.level = level
 
 	return &settings
 }
@@ -1,9 +1,9 @@
 package logger
 
 import (
+	"bytes"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/rivo/tview"
 	log "github.com/wtfutil/wtf/logger"
@@ -11,7 +11,11 @@ import (
 )
 
 const (
-	maxBufferSize int64 = 1024
+	// The log file is read backwards a chunk at a time, until enough entries that match
+	// the filters are found or too much of it has been read
+	chunkSize   int64 = 4096
+	maxEntries        = 100
+	maxReadSize int64 = 1024 * 1024
 )
 
 type Widget struct {
@@ -44,58 +48,110 @@ func (widget *Widget) content() (string, string, bool) {
 		return widget.CommonSettings().Title, "File missing", false
 	}
 
-	logLines := widget.tailFile()
+	roles := widget.settings.Colors.RoleTheme
 	str := ""
 
-	for _, line := range logLines {
-		chunks := strings.Split(line, " ")
-
-		if len(chunks) >= 4 {
-			str += fmt.Sprintf(
-				"[green]%s[white] [yellow]%s[white] %s\n",
-				chunks[0],
-				chunks[1],
-				strings.Join(chunks[3:], " "),
-			)
+	for _, entry := range widget.tailEntries() {
+		module := ""
+		if entry.Module != "" {
+			module = fmt.Sprintf("[%s]%s[-] ", roles.Accent, entry.Module)
 		}
+
+		str += fmt.Sprintf(
+			"[%s]%s[-] [%s]%-5s[-] %s%s\n",
+			roles.Ok,
+			entry.Time.Format("15:04:05"),
+			widget.levelColor(entry.Level),
+			entry.Level,
+			module,
+			tview.Escape(entry.Message),
+		)
 	}
 
 	return widget.CommonSettings().Title, str, false
 }
 
-func (widget *Widget) tailFile() []string {
+// shouldDisplay returns TRUE if the entry matches the configured level and modules
+func (widget *Widget) shouldDisplay(entry log.Entry) bool {
+	if entry.Level < widget.settings.level {
+		return false
+	}
+
+	if len(widget.settings.modules) == 0 {
+		return true
+	}
+
+	for _, module := range widget.settings.modules {
+		if module == entry.Module {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tailEntries returns the most recent log entries that should be displayed, newest first.
+// Filtering happens while the file is read, so that entries from busy modules don't push
+// the ones that match out of view
+func (widget *Widget) tailEntries() []log.Entry {
+	entries := []log.Entry{}
+
 	file, err := os.Open(widget.filePath)
 	if err != nil {
-		return []string{}
+		return entries
 	}
 	defer func() { _ = file.Close() }()
 
 	stat, err := file.Stat()
 	if err != nil {
-		return []string{}
+		return entries
 	}
 
-	bufferSize := maxBufferSize
-	if maxBufferSize > stat.Size() {
-		bufferSize = stat.Size()
-	}
+	// The start of the first line in a chunk is in the chunk before it
+	partial := []byte{}
+	end := stat.Size()
 
-	startPos := stat.Size() - bufferSize
+	for end > 0 && stat.Size()-end < maxReadSize && len(entries) < maxEntries {
+		start := end - chunkSize
+		if start < 0 {
+			start = 0
+		}
 
-	buff := make([]byte, bufferSize)
-	_, err = file.ReadAt(buff, startPos)
-	if err != nil {
-		return []string{}
-	}
+		chunk := make([]byte, end-start)
+		if _, err := file.ReadAt(chunk, start); err != nil {
+			return entries
+		}
 
-	logLines := strings.Split(string(buff), "\n")
+		lines := bytes.Split(append(chunk, partial...), []byte("\n"))
+		if start > 0 {
+			partial = lines[0]
+			lines = lines[1:]
+		}
 
-	// Reverse the array of lines
-	// Offset by two to account for the blank line at the end
-	last := len(logLines) - 2
-	for i := 0; i < len(logLines)/2; i++ {
-		logLines[i], logLines[last-i] = logLines[last-i], logLines[i]
+		for idx := len(lines) - 1; idx >= 0 && len(entries) < maxEntries; idx-- {
+			entry, ok := log.ParseEntry(string(lines[idx]))
+			if ok && widget.shouldDisplay(entry) {
+				entries = append(entries, entry)
+			}
+		}
+
+		end = start
 	}
 
-	return logLines
+	return entries
+}
+
+func (widget *Widget) levelColor(level log.Level) string {
+	roles := widget.settings.Colors.RoleTheme
+
+	switch level {
+	case log.LevelDebug:
+		return roles.Muted
+	case log.LevelWarn:
+		return roles.Warn
+	case log.LevelError:
+		return roles.Error
+	default:
+		return "-"
+	}
 }
@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	log "github.com/wtfutil/wtf/logger"
+)
+
+func Test_tailEntries(t *testing.T) {
+	lines := []string{"2024-05-01 10:00:00 ERROR [github] rate limited"}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("2024-05-01 10:00:01 DEBUG [clocks] tick %d", i))
+	}
+	lines = append(lines, "2024-05-01 10:00:02 WARN [github] retrying")
+
+	filePath := filepath.Join(t.TempDir(), "log.txt")
+	assert.NoError(t, os.WriteFile(filePath, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+	widget := &Widget{
+		filePath: filePath,
+		settings: &Settings{level: log.LevelWarn},
+	}
+
+	entries := widget.tailEntries()
+
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "retrying", entries[0].Message)
+	assert.Equal(t, "rate limited", entries[1].Message)
+
+	widget.settings = &Settings{level: log.LevelDebug, modules: []string{"clocks"}}
+
+	entries = widget.tailEntries()
+
+	assert.Len(t, entries, maxEntries)
+	assert.Equal(t, "tick 999", entries[0].Message)
+}
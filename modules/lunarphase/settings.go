@@ -27,7 +27,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		requestTimeout: ymlConfig.UInt("timeout", 30),
 	}
 
-	settings.SetDocumentationPath("lunarphase")
-
 	return &settings
 }
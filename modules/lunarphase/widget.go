@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"github.com/wtfutil/wtf/wtf"
@@ -50,7 +51,7 @@ func (widget *Widget) Refresh() {
 		widget.day = widget.date.Format(dateFormat)
 	}
 	if widget.day != widget.last {
-		widget.lunarPhase()
+		widget.SetRefreshError(widget.lunarPhase())
 	}
 
 	if !widget.settings.Enabled {
@@ -76,18 +77,17 @@ func (widget *Widget) RefreshTitle() {
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
-// this method reads the config and calls wttr.in for lunar phase
-func (widget *Widget) lunarPhase() {
-	client := &http.Client{
-		Timeout: widget.timeout,
-	}
+// this method reads the config and calls wttr.in for lunar phase, returning the error if it fails
+func (widget *Widget) lunarPhase() error {
+	client := httpclient.Client()
+	client.Timeout = widget.timeout
 
 	language := widget.settings.language
 
 	req, err := http.NewRequest("GET", "https://wttr.in/Moon@"+widget.day+"?AF&lang="+language, http.NoBody)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	req.Header.Set("Accept-Language", widget.settings.language)
@@ -95,18 +95,20 @@ func (widget *Widget) lunarPhase() {
 	response, err := client.Do(req)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 
 	contents, err := io.ReadAll(response.Body)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	widget.last = widget.day
 	widget.result = strings.TrimSpace(wtf.ASCIItoTviewColors(string(contents)))
+
+	return nil
 }
 
 // NextDay shows the next day's lunar phase (KeyRight / 'n')
@@ -17,14 +17,14 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 
 	title := fmt.Sprintf(
-		"%s - %s[white]",
+		"%s - %s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		repoData.Repository,
 	)
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.Data), widget.Idx, width) + "\n"
-	str += fmt.Sprintf(" [%s]Branch:Bookmark[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Branch:Bookmark[-]\n", widget.settings.Colors.Subheading)
 	str += fmt.Sprintf(" %s:%s\n", repoData.Branch, repoData.Bookmark)
 	str += "\n"
 	str += widget.formatChanges(repoData.ChangedFiles)
@@ -35,10 +35,10 @@ func (widget *Widget) content() (string, string, bool) {
 }
 
 func (widget *Widget) formatChanges(data []string) string {
-	str := fmt.Sprintf(" [%s]Changed Files[white]\n", widget.settings.Colors.Subheading)
+	str := fmt.Sprintf(" [%s]Changed Files[-]\n", widget.settings.Colors.Subheading)
 
 	if len(data) == 1 {
-		str += " [grey]none[white]\n"
+		str += " [grey]none[-]\n"
 	} else {
 		for _, line := range data {
 			str += widget.formatChange(line)
@@ -56,23 +56,25 @@ func (widget *Widget) formatChange(line string) string {
 	line = strings.TrimSpace(line)
 	firstChar, _ := utf8.DecodeRuneInString(line)
 
+	roles := widget.settings.Colors.RoleTheme
+
 	// Revisit this and kill the ugly duplication
 	switch firstChar {
 	case 'A':
-		line = strings.Replace(line, "A", "[green]A[white]", 1)
+		line = strings.Replace(line, "A", "["+roles.Ok+"]A[-]", 1)
 	case 'D':
-		line = strings.Replace(line, "D", "[red]D[white]", 1)
+		line = strings.Replace(line, "D", "["+roles.Error+"]D[-]", 1)
 	case 'M':
-		line = strings.Replace(line, "M", "[yellow]M[white]", 1)
+		line = strings.Replace(line, "M", "["+roles.Warn+"]M[-]", 1)
 	case 'R':
-		line = strings.Replace(line, "R", "[purple]R[white]", 1)
+		line = strings.Replace(line, "R", "[purple]R[-]", 1)
 	}
 
 	return fmt.Sprintf(" %s\n", strings.ReplaceAll(line, "\"", ""))
 }
 
 func (widget *Widget) formatCommits(data []string) string {
-	str := fmt.Sprintf(" [%s]Recent Commits[white]\n", widget.settings.Colors.Subheading)
+	str := fmt.Sprintf(" [%s]Recent Commits[-]\n", widget.settings.Colors.Subheading)
 
 	for _, line := range data {
 		str += widget.formatCommit(line)
@@ -24,7 +24,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
 		commitCount:  ymlConfig.UInt("commitCount", 10),
-		commitFormat: ymlConfig.UString("commitFormat", "[forestgreen]{rev}:{phase} [white]{desc|firstline|strip} [grey]{author|person} {date|age}[white]"),
+		commitFormat: ymlConfig.UString("commitFormat", "[forestgreen]{rev}:{phase} [-]{desc|firstline|strip} [grey]{author|person} {date|age}[-]"),
 		repositories: ymlConfig.UList("repositories"),
 	}
 
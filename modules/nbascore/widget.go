@@ -2,6 +2,7 @@ package nbascore
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -39,41 +41,49 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 }
 
 func (widget *Widget) Refresh() {
-	widget.Redraw(widget.nbascore)
+	widget.Redraw(func() (string, string, bool) {
+		title, content, wrap, err := widget.nbascore()
+		widget.SetRefreshError(err)
+
+		return title, content, wrap
+	})
 }
 
-func (widget *Widget) nbascore() (string, string, bool) {
+// nbascore returns the scores to display. If they can't be fetched, the error is also
+// returned as the text to display
+func (widget *Widget) nbascore() (string, string, bool, error) {
 	title := widget.CommonSettings().Title
 	cur := time.Now().AddDate(0, 0, offset) // Go back/forward offset days
 	curString := cur.Format("20060102")     // Need 20060102 format to feed to api
-	client := &http.Client{}
+	client := httpclient.Client()
 	req, err := http.NewRequest("GET", "http://data.nba.net/10s/prod/v1/"+curString+"/scoreboard.json", http.NoBody)
 	if err != nil {
-		return title, err.Error(), true
+		return title, err.Error(), true, err
 	}
 
 	req.Header.Set("Accept-Language", widget.language)
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
-		return title, err.Error(), true
+		return title, err.Error(), true, err
 	}
 	defer func() { _ = response.Body.Close() }()
 	if response.StatusCode != 200 {
-		return title, err.Error(), true
+		err = errors.New(response.Status)
+		return title, err.Error(), true, err
 	} // Get data from data.nba.net and check if successful
 
 	contents, err := io.ReadAll(response.Body)
 	if err != nil {
-		return title, err.Error(), true
+		return title, err.Error(), true, err
 	}
 	result := map[string]interface{}{}
 	err = json.Unmarshal(contents, &result)
 	if err != nil {
-		return title, err.Error(), true
+		return title, err.Error(), true, err
 	}
 
-	allGame := fmt.Sprintf(" [%s]", widget.settings.Colors.Subheading) + (cur.Format(utils.FriendlyDateFormat) + "\n\n") + "[white]"
+	allGame := fmt.Sprintf(" [%s]", widget.settings.Colors.Subheading) + (cur.Format(utils.FriendlyDateFormat) + "\n\n") + "[-]"
 
 	for _, game := range result["games"].([]interface{}) {
 		vTeam, hTeam, vScore, hScore := "", "", "", ""
@@ -117,18 +127,18 @@ func (widget *Widget) nbascore() (string, string, bool) {
 			case hNum > vNum:
 				// hScore = "[orange]" + hScore
 				hColor = "[orange]" // For correct padding
-				hTeam += "[white]"
+				hTeam += "[-]"
 			default:
 				vTeam = "[orange]" + vTeam
 				hColor = "[orange]"
-				hTeam += "[white]"
+				hTeam += "[-]"
 			}
 		}
-		qColor := "[white]"
+		qColor := "[-]"
 		if activate {
 			qColor = "[sandybrown]"
 		}
-		allGame += fmt.Sprintf("%s%5s%v[white] %s %3s [white]vs %s%-3s %s\n", qColor, "Q", quarter, vTeam, vScore, hColor, hScore, hTeam) // Format the score and store in allgame
+		allGame += fmt.Sprintf("%s%5s%v[-] %s %3s [-]vs %s%-3s %s\n", qColor, "Q", quarter, vTeam, vScore, hColor, hScore, hTeam) // Format the score and store in allgame
 	}
-	return title, allGame, false
+	return title, allGame, false, nil
 }
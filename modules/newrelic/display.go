@@ -14,15 +14,16 @@ func (widget *Widget) content() (string, string, bool) {
 		return widget.CommonSettings().Title, " NewRelic data unavailable ", false
 	}
 	app, appErr := client.Application()
-	deploys, depErr := client.Deployments()
-
-	appName := "error"
-	if appErr == nil {
-		appName = app.Name
+	if appErr != nil {
+		widget.SetRefreshError(appErr)
+		return widget.CommonSettings().Title, appErr.Error(), true
 	}
 
+	deploys, depErr := client.Deployments()
+	widget.SetRefreshError(depErr)
+
 	var content string
-	title := fmt.Sprintf("%s - [green]%s[white]", widget.CommonSettings().Title, appName)
+	title := fmt.Sprintf("%s - [%s]%s[-]", widget.CommonSettings().Title, widget.settings.Colors.RoleTheme.Accent, app.Name)
 	wrap := false
 	if depErr != nil {
 		wrap = true
@@ -38,7 +39,7 @@ func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 	str := fmt.Sprintf(
 		" %s\n",
 		fmt.Sprintf(
-			"[%s]Latest Deploys[white]",
+			"[%s]Latest Deploys[-]",
 			widget.settings.Colors.Subheading,
 		),
 	)
@@ -47,7 +48,7 @@ func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 
 	for _, deploy := range deploys {
 		if (deploy.Revision != "") && utils.DoesNotInclude(revisions, deploy.Revision) {
-			lineColor := "white"
+			lineColor := "-"
 			if wtf.IsToday(deploy.Timestamp) {
 				lineColor = "lightblue"
 			}
@@ -58,7 +59,8 @@ func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 			}
 
 			str += fmt.Sprintf(
-				" [green]%s[%s] %s %-.16s[white]\n",
+				" [%s]%s[%s] %s %-.16s[-]\n",
+				widget.settings.Colors.RoleTheme.Accent,
 				deploy.Revision[0:revLen],
 				lineColor,
 				deploy.Timestamp.Format("Jan 02 15:04 MST"),
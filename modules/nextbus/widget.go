@@ -4,10 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"strconv"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/view"
 )
@@ -40,7 +40,10 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() string {
-	return getNextBus(widget.settings.agency, widget.settings.route, widget.settings.stopID)
+	nextBus, err := getNextBus(widget.settings.agency, widget.settings.route, widget.settings.stopID)
+	widget.SetRefreshError(err)
+
+	return nextBus
 }
 
 type AutoGenerated struct {
@@ -75,18 +78,20 @@ type Predictions struct {
 	Direction   Direction `json:"direction"`
 }
 
-func getNextBus(agency string, route string, stopID string) string {
+// getNextBus returns the predictions to display. If they can't be fetched, a message
+// saying so is returned as the text to display, along with the error
+func getNextBus(agency string, route string, stopID string) (string, error) {
 	url := fmt.Sprintf("https://webservices.umoiq.com/service/publicJSONFeed?command=predictions&a=%s&r=%s&stopId=%s", agency, route, stopID)
-	resp, err := http.Get(url)
+	resp, err := httpclient.Client().Get(url)
 	if err != nil {
-		logger.Log(fmt.Sprintf("[nextbus] Error: Failed to make requests to umoiq for next bus predictions. Reason: %s", err))
-		return "[nextbus] error calling umoiq"
+		logger.Module("nextbus").Errorf("Failed to make requests to umoiq for next bus predictions. Reason: %s", err)
+		return "[nextbus] error calling umoiq", err
 	}
 	body, readErr := io.ReadAll(resp.Body)
 
 	if (readErr) != nil {
-		logger.Log(fmt.Sprintf("[nextbus] Error: Failed to parse response body from umoiq. Reason: %s", err))
-		return "[nextbus] error parsing response body"
+		logger.Module("nextbus").Errorf("Failed to parse response body from umoiq. Reason: %s", err)
+		return "[nextbus] error parsing response body", readErr
 	}
 
 	resp.Body.Close()
@@ -96,8 +101,8 @@ func getNextBus(agency string, route string, stopID string) string {
 	// partial unmarshal, we don't have r.Predictions.Direction.PredictionRaw <- YET
 	unmarshalError := json.Unmarshal(body, &parsedResponse)
 	if unmarshalError != nil {
-		logger.Log(fmt.Sprintf("[nextbus] Error: Failed to unmarshal body from umoiq. Reason: %s", err))
-		return "[nextbus] error unmarshalling response body"
+		logger.Module("nextbus").Errorf("Failed to unmarshal body from umoiq. Reason: %s", err)
+		return "[nextbus] error unmarshalling response body", unmarshalError
 	}
 
 	parseType := ""
@@ -123,7 +128,7 @@ func getNextBus(agency string, route string, stopID string) string {
 		finalStr += fmt.Sprintf("%s | ETA [%s]\n", parsedResponse.Predictions.RouteTitle, strTimeToInt(nextBusObject.Minutes, nextBusObject.Seconds))
 	}
 
-	return finalStr
+	return finalStr, nil
 }
 
 // takes minutes and seconds from the API, does math to find the remainder seconds
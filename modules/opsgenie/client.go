@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 type OnCallResponse struct {
@@ -59,7 +61,7 @@ func opsGenieRequest(url string, apiKey string) (*OnCallResponse, error) {
 
 	req.Header.Set("Authorization", fmt.Sprintf("GenieKey %s", apiKey))
 
-	client := &http.Client{}
+	client := httpclient.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -38,6 +38,7 @@ func (widget *Widget) content() (string, string, bool) {
 		widget.settings.scheduleIdentifierType,
 		widget.settings.schedule,
 	)
+	widget.SetRefreshError(err)
 	title := widget.CommonSettings().Title
 
 	var content string
@@ -54,7 +55,7 @@ func (widget *Widget) content() (string, string, bool) {
 
 			var msg string
 			if len(data.OnCallData.Recipients) == 0 {
-				msg = " [gray]no one[white]\n\n"
+				msg = fmt.Sprintf(" [%s]no one[-]\n\n", widget.settings.Colors.RoleTheme.Muted)
 			} else {
 				msg = fmt.Sprintf(" %s\n\n", strings.Join(utils.NamesFromEmails(data.OnCallData.Recipients), ", "))
 			}
@@ -69,5 +70,5 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) cleanScheduleName(schedule string) string {
 	cleanedName := strings.ReplaceAll(schedule, "_", " ")
-	return fmt.Sprintf(" [green]%s[white]\n", cleanedName)
+	return fmt.Sprintf(" [%s]%s[-]\n", widget.settings.Colors.RoleTheme.Accent, cleanedName)
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 const (
@@ -13,7 +14,7 @@ const (
 
 // GetOnCalls returns a list of people currently on call
 func GetOnCalls(apiKey string, scheduleIDs []string) ([]pagerduty.OnCall, error) {
-	client := pagerduty.NewClient(apiKey)
+	client := newClient(apiKey)
 
 	var results []pagerduty.OnCall
 	var queryOpts pagerduty.ListOnCallOptions
@@ -43,7 +44,7 @@ func GetOnCalls(apiKey string, scheduleIDs []string) ([]pagerduty.OnCall, error)
 
 // GetIncidents returns a list of unresolved incidents
 func GetIncidents(apiKey string, teamIDs []string, userIDs []string) ([]pagerduty.Incident, error) {
-	client := pagerduty.NewClient(apiKey)
+	client := newClient(apiKey)
 
 	var results []pagerduty.Incident
 
@@ -70,3 +71,12 @@ func GetIncidents(apiKey string, teamIDs []string, userIDs []string) ([]pagerdut
 
 	return results, nil
 }
+
+// newClient returns a PagerDuty client that makes its requests with the configured HTTP
+// client
+func newClient(apiKey string) *pagerduty.Client {
+	client := pagerduty.NewClient(apiKey)
+	client.HTTPClient = httpclient.Client()
+
+	return client
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -16,6 +17,12 @@ const (
 	onCallTimeDisplayLayout = "Jan 2, 2006"
 )
 
+// cachedData is what's cached after each successful refresh
+type cachedData struct {
+	Incidents []pagerduty.Incident `json:"incidents"`
+	OnCalls   []pagerduty.OnCall   `json:"onCalls"`
+}
+
 type Widget struct {
 	view.TextWidget
 
@@ -53,24 +60,55 @@ func (widget *Widget) Refresh() {
 		onCalls, err1 = GetOnCalls(widget.settings.apiKey, scheduleIDs)
 	}
 
+	err := err1
+	if err == nil {
+		err = err2
+	}
+	widget.SetRefreshError(err)
+
 	var content string
 	wrap := false
 	if err1 != nil || err2 != nil {
-		wrap = true
-		if err1 != nil {
-			content += err1.Error()
-		}
-		if err2 != nil {
-			content += err2.Error()
+		cached := cachedData{}
+		if widget.LoadCache(&cached) {
+			// Show what was on call from the last successful refresh until PagerDuty
+			// can be reached again
+			content = widget.contentFrom(cached.OnCalls, cached.Incidents)
+		} else {
+			wrap = true
+			if err1 != nil {
+				content += err1.Error()
+			}
+			if err2 != nil {
+				content += err2.Error()
+			}
 		}
 	} else {
 		widget.View.SetWrap(false)
 		content = widget.contentFrom(onCalls, incidents)
+
+		widget.SaveCache(cachedData{Incidents: incidents, OnCalls: onCalls})
+
+		if widget.settings.showIncidents {
+			widget.RaiseEvents(incidentEvents(incidents))
+		}
 	}
 
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, content, wrap })
 }
 
+// RenderCached displays the incidents and who's on call from the last successful
+// refresh, until the first one
+func (widget *Widget) RenderCached() {
+	cached := cachedData{}
+	if !widget.LoadCache(&cached) {
+		return
+	}
+
+	content := widget.contentFrom(cached.OnCalls, cached.Incidents)
+	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, content, false })
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerduty.Incident) string {
@@ -79,11 +117,11 @@ func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerd
 	// Incidents
 
 	if widget.settings.showIncidents {
-		str += fmt.Sprintf("[%s] Incidents[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("[%s] Incidents[-]\n", widget.settings.Colors.Subheading)
 
 		if len(incidents) > 0 {
 			for _, incident := range incidents {
-				str += fmt.Sprintf("\n [%s]%s[white]\n", widget.settings.Colors.Label, tview.Escape(incident.Summary))
+				str += fmt.Sprintf("\n [%s]%s[-]\n", widget.settings.Colors.Label, tview.Escape(incident.Summary))
 				str += fmt.Sprintf("     Status: %s\n", incident.Status)
 				str += fmt.Sprintf("    Service: %s\n", incident.Service.Summary)
 				str += fmt.Sprintf(" Escalation: %s\n", incident.EscalationPolicy.Summary)
@@ -121,7 +159,7 @@ func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerd
 	sort.Strings(keys)
 
 	if len(keys) > 0 {
-		str += fmt.Sprintf("[%s] Schedules[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("[%s] Schedules[-]\n", widget.settings.Colors.Subheading)
 
 		// Print out policies, and escalation order of users
 		for _, key := range keys {
@@ -184,3 +222,26 @@ func (widget *Widget) userSummary(onCall *pagerduty.OnCall) string {
 
 	return summary
 }
+
+// incidentEvents turns the open incidents into events, so that new ones can be alerted on
+func incidentEvents(incidents []pagerduty.Incident) []notify.Event {
+	events := []notify.Event{}
+
+	for _, incident := range incidents {
+		severity := notify.SeverityError
+		if incident.Status == "acknowledged" {
+			severity = notify.SeverityWarn
+		}
+
+		events = append(events, notify.Event{
+			Key:      incident.ID,
+			Kind:     "incident",
+			Message:  fmt.Sprintf("%s (%s)", incident.Service.Summary, incident.Status),
+			Severity: severity,
+			Title:    incident.Summary,
+			URL:      incident.HTMLURL,
+		})
+	}
+
+	return events
+}
@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 type Status struct {
@@ -374,13 +376,8 @@ func (widget *Widget) adblockSwitch(action string) {
 }
 
 func getClient() http.Client {
-	return http.Client{
-		Transport: &http.Transport{
-			TLSHandshakeTimeout:   5 * time.Second,
-			DisableKeepAlives:     false,
-			DisableCompression:    false,
-			ResponseHeaderTimeout: 20 * time.Second,
-		},
-		Timeout: 21 * time.Second,
-	}
+	client := httpclient.Client()
+	client.Timeout = 21 * time.Second
+
+	return *client
 }
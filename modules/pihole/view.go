@@ -26,13 +26,15 @@ func getSummaryView(c http.Client, settings *Settings) string {
 
 	buf := new(bytes.Buffer)
 
+	roles := settings.Colors.RoleTheme
+
 	switch strings.ToLower(s.Status) {
 	case "disabled":
-		sb.WriteString(" [white]Status [red]DISABLED\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]DISABLED\n", roles.Error))
 	case "enabled":
-		sb.WriteString(" [white]Status [green]ENABLED\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]ENABLED\n", roles.Ok))
 	default:
-		sb.WriteString(" [white]Status [yellow]UNKNOWN\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]UNKNOWN\n", roles.Warn))
 	}
 
 	summaryTable := createTable([]string{}, buf)
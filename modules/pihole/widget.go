@@ -48,7 +48,9 @@ func (widget *Widget) content() (string, string, bool) {
 
 	c := getClient()
 
-	if err := checkServer(c, widget.settings.apiUrl); err != nil {
+	err := checkServer(c, widget.settings.apiUrl)
+	widget.SetRefreshError(err)
+	if err != nil {
 		return title, err.Error(), widget.settings.wrapText
 	}
 
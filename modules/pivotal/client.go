@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 type Resource struct {
@@ -41,11 +43,8 @@ func NewPivotalClient(token string, projectId string) *PivotalClient {
 }
 
 func (pivotal *PivotalClient) apiv5(resource string) (*Resource, error) {
-	trn := &http.Transport{}
 	meth := "GET"
-	client := &http.Client{
-		Transport: trn,
-	}
+	client := httpclient.Client()
 
 	apiToken := pivotal.token
 	URL := fmt.Sprintf("%s%s", pivotal.baseUrl, resource)
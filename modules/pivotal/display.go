@@ -40,7 +40,7 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 
 	title := fmt.Sprintf(
-		"[%s]%s[white] - %d ",
+		"[%s]%s[-] - %d ",
 		widget.settings.Colors.TextTheme.Title,
 		proj.name, proj.getItemCount())
 
@@ -23,11 +23,11 @@ func NewPivotalSource(name string, filter string, client *PivotalClient, widget
 		client: client,
 		widget: widget,
 	}
-	source.loadStories()
 	return &source
 }
 
-func (source *PivotalSource) loadStories() {
+// loadStories fetches the stories matching the source's filter, returning the error if it fails
+func (source *PivotalSource) loadStories() error {
 	search, err := source.client.searchStories(source.filter)
 	if err != nil {
 		source.stories = nil
@@ -38,6 +38,8 @@ func (source *PivotalSource) loadStories() {
 		source.Err = err
 		source.setItemCount(len(source.stories))
 	}
+
+	return source.Err
 }
 
 // Open: Will open Pivotal search url with filter applied using the utils helper
@@ -87,6 +87,15 @@ func (widget *Widget) Refresh() {
 	if widget.Disabled() {
 		return
 	}
+
+	var err error
+	for _, source := range widget.sources {
+		if sourceErr := source.loadStories(); sourceErr != nil && err == nil {
+			err = sourceErr
+		}
+	}
+	widget.SetRefreshError(err)
+
 	widget.SetItemCount(widget.CurrentSource().getItemCount())
 	widget.display()
 }
@@ -0,0 +1,42 @@
+/*
+Package plugin displays modules implemented by external executables, so that data sources
+which don't belong in WTF itself can still be shown as native-looking widgets.
+
+	builds:
+	  type: plugin
+	  command: /usr/local/bin/wtf-builds
+	  args: ["--verbose"]
+	  config:
+	    project: wtf
+	  position: ...
+
+The plugin is launched on the widget's first refresh and talks to WTF over its stdin and
+stdout, one JSON object per line. Anything written to stderr goes to the WTF log. If the
+plugin exits it's relaunched on the next refresh.
+
+WTF sends:
+
+	{"type":"init","version":1,"name":"builds","config":{...},"width":40,"height":10}
+	{"type":"refresh","width":40,"height":10}
+	{"type":"key","key":"j"}
+
+init is sent once, straight after launch, with the module's config block. refresh is
+sent on every refresh interval, when the user presses "r", and when the widget changes
+size, such as when it's zoomed to fill the screen. key is sent when the user presses one
+of the keys the plugin asked for.
+
+The plugin sends:
+
+	{"type":"ready","version":1,"help":"Shows builds","keys":[{"key":"j","help":"Next build"}]}
+	{"type":"render","title":"Builds","content":"[green]passing[-]","wrap":false}
+	{"type":"error","message":"could not reach the build server"}
+	{"type":"log","level":"info","message":"fetched 12 builds"}
+
+ready is the answer to init, and declares the protocol version the plugin speaks along
+with its help text and keybindings. It must be sent before the first render, which is
+refused otherwise. Keys are either single characters or special key
+names such as "Enter" or "Ctrl-D". render replaces the widget's title and content, which
+can use the usual tview color tags. error marks the widget as failing until the next
+render.
+*/
+package plugin
@@ -0,0 +1,210 @@
+package plugin
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+// maxLineSize is the longest single message a plugin may send. Content is redrawn in
+// full on every render, so this needs to comfortably hold a screenful of text
+const maxLineSize = 1024 * 1024
+
+// outboxSize is how many messages can wait to be written to a plugin that isn't reading
+// its input. Messages sent while it's full are dropped, so that a stuck plugin never
+// blocks the key handling that sends it key events
+const outboxSize = 16
+
+// errOutboxFull is returned when a message is dropped because the plugin isn't reading
+var errOutboxFull = errors.New("plugin isn't reading its input, message dropped")
+
+// process is a running plugin executable and the pipes used to talk to it
+type process struct {
+	cmd     *exec.Cmd
+	done    chan struct{}
+	err     error
+	m       sync.Mutex
+	outbox  chan []byte
+	quit    chan struct{}
+	stdin   io.WriteCloser
+	stopped bool
+}
+
+// startProcess launches the plugin and starts reading from it. Each message written to
+// stdout is passed to handle, each line written to stderr is passed to stderr, and once
+// the plugin exits its exit status is passed to exited
+func startProcess(cmd *exec.Cmd, handle func(pluginMessage, error), stderr func(string), exited func(error)) (*process, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	errOut, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	proc := &process{
+		cmd:    cmd,
+		done:   make(chan struct{}),
+		outbox: make(chan []byte, outboxSize),
+		quit:   make(chan struct{}),
+		stdin:  stdin,
+	}
+
+	go proc.write()
+
+	var readers sync.WaitGroup
+	readers.Add(2)
+
+	go func() {
+		defer readers.Done()
+
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+		ready := false
+
+		for scanner.Scan() {
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+
+			msg, err := decodeMessage(scanner.Bytes(), ready)
+			if err == nil && msg.Type == msgReady {
+				ready = true
+			}
+
+			handle(msg, err)
+		}
+
+		if err := scanner.Err(); err != nil {
+			handle(pluginMessage{}, err)
+		}
+
+		// Drain anything left so that the plugin never blocks writing to a full pipe
+		_, _ = io.Copy(io.Discard, stdout)
+	}()
+
+	go func() {
+		defer readers.Done()
+
+		scanner := bufio.NewScanner(errOut)
+		for scanner.Scan() {
+			stderr(scanner.Text())
+		}
+	}()
+
+	go func() {
+		readers.Wait()
+
+		err := cmd.Wait()
+		if err == nil {
+			err = errors.New("plugin exited")
+		} else {
+			err = fmt.Errorf("plugin exited: %w", err)
+		}
+
+		proc.m.Lock()
+		proc.err = err
+		proc.m.Unlock()
+
+		close(proc.done)
+		exited(err)
+	}()
+
+	return proc, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// exited returns true once the plugin process has gone away
+func (proc *process) exited() bool {
+	select {
+	case <-proc.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// send queues a single message to be written to the plugin's stdin. It never waits for
+// the plugin to read it: if too many messages are already waiting it's dropped instead
+func (proc *process) send(msg hostMessage) error {
+	data, err := encodeMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	proc.m.Lock()
+	defer proc.m.Unlock()
+
+	if proc.err != nil {
+		return proc.err
+	}
+
+	if proc.stopped {
+		return errors.New("plugin has been stopped")
+	}
+
+	select {
+	case proc.outbox <- data:
+		return nil
+	default:
+		return errOutboxFull
+	}
+}
+
+// stop closes the plugin's stdin and kills it if it hasn't already exited
+func (proc *process) stop() {
+	proc.m.Lock()
+	if !proc.stopped {
+		proc.stopped = true
+		close(proc.quit)
+	}
+	proc.m.Unlock()
+
+	// Closing stdin also interrupts a write that's waiting for the plugin to read
+	_ = proc.stdin.Close()
+
+	if proc.exited() {
+		return
+	}
+
+	if proc.cmd.Process != nil {
+		_ = proc.cmd.Process.Kill()
+	}
+}
+
+// write writes the queued messages to the plugin's stdin until it's stopped. A write that
+// fails means the plugin has closed its input, so every later message fails too
+func (proc *process) write() {
+	for {
+		select {
+		case data := <-proc.outbox:
+			if _, err := proc.stdin.Write(data); err != nil {
+				proc.m.Lock()
+				if proc.err == nil {
+					proc.err = fmt.Errorf("writing to plugin: %w", err)
+				}
+				proc.m.Unlock()
+				return
+			}
+		case <-proc.quit:
+			return
+		}
+	}
+}
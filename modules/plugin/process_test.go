@@ -0,0 +1,98 @@
+package plugin
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_startProcess(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell available")
+	}
+
+	// Announces itself, then answers each message it receives with a render echoing it back
+	script := `printf '{"type":"ready","version":1}\n'; while read -r line; do printf '{"type":"render","content":%s}\n' "$(printf '%s' "$line" | sed 's/"/\\"/g; s/^/"/; s/$/"/')"; done`
+
+	messages := make(chan pluginMessage, 2)
+	exited := make(chan error, 1)
+
+	proc, err := startProcess(
+		exec.Command(sh, "-c", script),
+		func(msg pluginMessage, err error) {
+			assert.NoError(t, err)
+			messages <- msg
+		},
+		func(string) {},
+		func(err error) { exited <- err },
+	)
+	assert.NoError(t, err)
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, msgReady, msg.Type)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the plugin")
+	}
+
+	assert.NoError(t, proc.send(hostMessage{Type: msgRefresh, Width: 20}))
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, msgRender, msg.Type)
+		assert.Equal(t, `{"type":"refresh","width":20}`, msg.Content)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the plugin")
+	}
+
+	proc.stop()
+
+	select {
+	case err := <-exited:
+		assert.Error(t, err)
+		assert.True(t, proc.exited())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the plugin to exit")
+	}
+
+	assert.Error(t, proc.send(hostMessage{Type: msgRefresh}))
+}
+
+func Test_sendToStuckPlugin(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell available")
+	}
+
+	// Never reads its input, so the pipe to it fills up
+	proc, err := startProcess(
+		exec.Command(sh, "-c", "sleep 30"),
+		func(pluginMessage, error) {},
+		func(string) {},
+		func(error) {},
+	)
+	assert.NoError(t, err)
+	defer proc.stop()
+
+	payload := hostMessage{Type: msgKey, Key: strings.Repeat("x", 4096)}
+
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		for i := 0; i < 1000 && err == nil; i++ {
+			err = proc.send(payload)
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, errOutboxFull, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("sending to a plugin that isn't reading blocked")
+	}
+}
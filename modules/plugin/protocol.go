@@ -0,0 +1,104 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ProtocolVersion is the version of the stdio protocol spoken by this host. A plugin
+// announces the version it speaks in its ready message and is refused if they differ
+const ProtocolVersion = 1
+
+// Messages sent from WTF to the plugin
+const (
+	msgInit    = "init"
+	msgKey     = "key"
+	msgRefresh = "refresh"
+)
+
+// Messages sent from the plugin to WTF
+const (
+	msgError  = "error"
+	msgLog    = "log"
+	msgReady  = "ready"
+	msgRender = "render"
+)
+
+// hostMessage is a single line of JSON written to the plugin's stdin
+type hostMessage struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Name    string                 `json:"name,omitempty"`
+	Config  map[string]interface{} `json:"config,omitempty"`
+	Key     string                 `json:"key,omitempty"`
+	Width   int                    `json:"width,omitempty"`
+	Height  int                    `json:"height,omitempty"`
+}
+
+// Keybinding is a key the plugin wants to respond to. Key is either a single character,
+// ie: "j", or the name of a special key as shown in the help text, ie: "Enter", "Ctrl-D"
+type Keybinding struct {
+	Key  string `json:"key"`
+	Help string `json:"help"`
+}
+
+// pluginMessage is a single line of JSON read from the plugin's stdout
+type pluginMessage struct {
+	Type string `json:"type"`
+
+	// ready
+	Version int          `json:"version"`
+	Help    string       `json:"help"`
+	Keys    []Keybinding `json:"keys"`
+
+	// render
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	Wrap    bool   `json:"wrap"`
+
+	// error and log
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// encodeMessage serializes a message for the plugin as a single newline-terminated line
+func encodeMessage(msg hostMessage) ([]byte, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// decodeMessage parses and sanity checks a line written by the plugin. Until the plugin
+// has announced the protocol version it speaks in a valid ready message, ready is false
+// and it's refused anything to render
+func decodeMessage(line []byte, ready bool) (pluginMessage, error) {
+	msg := pluginMessage{}
+
+	err := json.Unmarshal(line, &msg)
+	if err != nil {
+		return msg, fmt.Errorf("invalid message from plugin: %w", err)
+	}
+
+	switch msg.Type {
+	case msgReady:
+		if msg.Version != ProtocolVersion {
+			return msg, fmt.Errorf("plugin speaks protocol version %d, expected %d", msg.Version, ProtocolVersion)
+		}
+	case msgRender:
+		if !ready {
+			return msg, fmt.Errorf("plugin sent %s before %s", msgRender, msgReady)
+		}
+	case msgError, msgLog:
+	case "":
+		return msg, fmt.Errorf("message from plugin has no type")
+	default:
+		return msg, fmt.Errorf("unknown message type from plugin: %s", msg.Type)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		ready       bool
+		expectedErr bool
+		expected    pluginMessage
+	}{
+		{
+			name:     "render",
+			line:     `{"type":"render","title":"Builds","content":"[green]ok[white]","wrap":true}`,
+			ready:    true,
+			expected: pluginMessage{Type: msgRender, Title: "Builds", Content: "[green]ok[white]", Wrap: true},
+		},
+		{
+			name:        "render before ready",
+			line:        `{"type":"render","content":"hello"}`,
+			expectedErr: true,
+		},
+		{
+			name:     "ready",
+			line:     `{"type":"ready","version":1,"help":"Shows builds","keys":[{"key":"j","help":"Next"}]}`,
+			expected: pluginMessage{Type: msgReady, Version: 1, Help: "Shows builds", Keys: []Keybinding{{Key: "j", Help: "Next"}}},
+		},
+		{
+			name:        "wrong version",
+			line:        `{"type":"ready","version":2}`,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown type",
+			line:        `{"type":"draw"}`,
+			expectedErr: true,
+		},
+		{
+			name:        "no type",
+			line:        `{"content":"hello"}`,
+			expectedErr: true,
+		},
+		{
+			name:        "not json",
+			line:        `hello`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := decodeMessage([]byte(tt.line), tt.ready)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_encodeMessage(t *testing.T) {
+	data, err := encodeMessage(hostMessage{
+		Type:    msgInit,
+		Version: ProtocolVersion,
+		Name:    "builds",
+		Config:  map[string]interface{}{"project": "wtf"},
+		Width:   40,
+		Height:  10,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"init","version":1,"name":"builds","config":{"project":"wtf"},"width":40,"height":10}`+"\n", string(data))
+
+	data, err = encodeMessage(hostMessage{Type: msgKey, Key: "Ctrl-D"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"key","key":"Ctrl-D"}`+"\n", string(data))
+}
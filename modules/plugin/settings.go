@@ -0,0 +1,60 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "Plugin"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	*cfg.Common
+
+	args       []string               `help:"The arguments to pass to the plugin executable." optional:"true"`
+	command    string                 `help:"The plugin executable to launch. Either an absolute path or a command found on your PATH." optional:"false"`
+	config     map[string]interface{} `help:"Plugin-specific settings, passed through to the plugin as-is." optional:"true"`
+	env        map[string]string      `help:"Additional environment variables to set for the plugin." optional:"true"`
+	workingDir string                 `help:"Working directory for the plugin to run in." optional:"true"`
+
+	// The dimensions of the module
+	width  int
+	height int
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, moduleConfig, globalConfig),
+
+		args:       utils.ToStrs(moduleConfig.UList("args")),
+		command:    moduleConfig.UString("command"),
+		config:     map[string]interface{}{},
+		env:        map[string]string{},
+		workingDir: moduleConfig.UString("workingDir", "."),
+	}
+
+	if pluginConfig, err := moduleConfig.Get("config"); err == nil {
+		if root, ok := pluginConfig.Root.(map[string]interface{}); ok {
+			settings.config = root
+		}
+	}
+
+	for key, val := range moduleConfig.UMap("env") {
+		settings.env[key] = fmt.Sprintf("%v", val)
+	}
+
+	width, height, err := utils.CalculateDimensions(moduleConfig, globalConfig)
+	if err == nil {
+		settings.width = width
+		settings.height = height
+	}
+
+	return &settings
+}
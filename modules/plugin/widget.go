@@ -0,0 +1,318 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/view"
+)
+
+// Widget is the container for the plugin's data
+type Widget struct {
+	view.TextWidget
+
+	settings *Settings
+
+	m         sync.Mutex
+	boundKeys map[string]bool
+	content   string
+	help      string
+	proc      *process
+	title     string
+	wrap      bool
+}
+
+// NewWidget creates and returns an instance of Widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		settings:  settings,
+		boundKeys: map[string]bool{},
+	}
+
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+	widget.SetHelpTextFunc(widget.HelpText)
+
+	widget.View.SetScrollable(true)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// HelpText returns the plugin's own description followed by its keyboard commands
+func (widget *Widget) HelpText() string {
+	widget.m.Lock()
+	help := widget.help
+	widget.m.Unlock()
+
+	if help == "" {
+		return widget.KeyboardWidget.HelpText()
+	}
+
+	return fmt.Sprintf(" %s\n\n%s", help, widget.KeyboardWidget.HelpText())
+}
+
+// Refresh asks the plugin to redraw itself, launching it first if it isn't running
+func (widget *Widget) Refresh() {
+	proc, err := widget.running()
+	if err == nil {
+		width, height := widget.size()
+
+		err = proc.send(hostMessage{
+			Type:   msgRefresh,
+			Width:  width,
+			Height: height,
+		})
+	}
+
+	if err != nil {
+		widget.SetRefreshError(err)
+		widget.display()
+	}
+
+	// On success the plugin's render message updates the refresh state
+}
+
+// Resize tells the plugin the widget's new size, in the refresh message it's sent to
+// render again at that size
+func (widget *Widget) Resize(width, height int) {
+	widget.m.Lock()
+	widget.settings.width = width
+	widget.settings.height = height
+	widget.m.Unlock()
+
+	widget.Refresh()
+}
+
+// Stop shuts down the plugin along with the widget
+func (widget *Widget) Stop() {
+	// Disable first so that the plugin exiting isn't reported as a failure
+	widget.TextWidget.Stop()
+
+	widget.m.Lock()
+	proc := widget.proc
+	widget.proc = nil
+	widget.m.Unlock()
+
+	if proc != nil {
+		proc.stop()
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// bindKeys assigns the keys the plugin asked for to forward their key events to it. It
+// must be called on the tview goroutine as it modifies the widget's key mappings
+func (widget *Widget) bindKeys(keys []Keybinding) {
+	for _, binding := range keys {
+		name := binding.Key
+
+		// Plugins are restarted when they exit, and bring their keybindings with them
+		if widget.boundKeys[name] {
+			continue
+		}
+
+		key, char, _, err := cfg.ParseKey(name)
+		if err != nil {
+			widget.Logger().Warnf("plugin keybinding %q is not a known key", name)
+			continue
+		}
+
+		forward := func() { widget.sendKey(name) }
+
+		if key == tcell.KeyRune {
+			widget.SetKeyboardChar(string(char), forward, binding.Help)
+		} else {
+			widget.SetKeyboardKey(key, forward, binding.Help)
+		}
+
+		widget.boundKeys[name] = true
+	}
+}
+
+func (widget *Widget) command() *exec.Cmd {
+	cmd := exec.Command(widget.settings.command, widget.settings.args...)
+	cmd.Dir = widget.settings.workingDir
+
+	cmd.Env = append(
+		os.Environ(),
+		fmt.Sprintf("WTF_PLUGIN_PROTOCOL=%d", ProtocolVersion),
+		fmt.Sprintf("WTF_WIDGET_WIDTH=%d", widget.settings.width),
+		fmt.Sprintf("WTF_WIDGET_HEIGHT=%d", widget.settings.height),
+	)
+	for key, val := range widget.settings.env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, val))
+	}
+
+	return cmd
+}
+
+func (widget *Widget) contents() (string, string, bool) {
+	widget.m.Lock()
+	defer widget.m.Unlock()
+
+	title := widget.title
+	if title == "" {
+		title = widget.CommonSettings().Title
+	}
+
+	if err := widget.RefreshError(); err != nil {
+		return title, err.Error(), true
+	}
+
+	return title, widget.content, widget.wrap
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.contents)
+}
+
+// handle processes a single message from the plugin
+func (widget *Widget) handle(msg pluginMessage, err error) {
+	if err != nil {
+		widget.Logger().Error(err.Error())
+		widget.SetRefreshError(err)
+		widget.display()
+		return
+	}
+
+	switch msg.Type {
+	case msgError:
+		widget.SetRefreshError(errors.New(msg.Message))
+		widget.display()
+
+	case msgLog:
+		widget.log(msg.Level, msg.Message)
+
+	case msgReady:
+		widget.m.Lock()
+		widget.help = msg.Help
+		widget.m.Unlock()
+
+		// Binding keys has to happen on the tview goroutine, which may not be running yet,
+		// and waiting for it here would stop this plugin's messages from being read
+		keys := msg.Keys
+		go widget.QueueUpdate(func() { widget.bindKeys(keys) })
+
+	case msgRender:
+		widget.m.Lock()
+		widget.content = msg.Content
+		widget.title = msg.Title
+		widget.wrap = msg.Wrap
+		widget.m.Unlock()
+
+		widget.SetRefreshError(nil)
+		widget.display()
+	}
+}
+
+// log writes a message from the plugin to the WTF log, tagged with this widget's name
+func (widget *Widget) log(levelName, msg string) {
+	level, err := logger.ParseLevel(levelName)
+	if err != nil {
+		level = logger.LevelInfo
+	}
+
+	switch level {
+	case logger.LevelDebug:
+		widget.Logger().Debug(msg)
+	case logger.LevelWarn:
+		widget.Logger().Warn(msg)
+	case logger.LevelError:
+		widget.Logger().Error(msg)
+	default:
+		widget.Logger().Info(msg)
+	}
+}
+
+// running returns the plugin process, launching it and sending it its init message if
+// it isn't running yet or has exited since the last refresh
+func (widget *Widget) running() (*process, error) {
+	widget.m.Lock()
+	defer widget.m.Unlock()
+
+	if widget.proc != nil && !widget.proc.exited() {
+		return widget.proc, nil
+	}
+
+	if !widget.Enabled() {
+		return nil, errors.New("widget has been stopped")
+	}
+
+	if widget.settings.command == "" {
+		return nil, errors.New("no plugin command configured")
+	}
+
+	proc, err := startProcess(
+		widget.command(),
+		widget.handle,
+		func(line string) { widget.Logger().Warn(line) },
+		widget.stopped,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = proc.send(hostMessage{
+		Type:    msgInit,
+		Version: ProtocolVersion,
+		Name:    widget.Name(),
+		Config:  widget.settings.config,
+		Width:   widget.settings.width,
+		Height:  widget.settings.height,
+	})
+	if err != nil {
+		proc.stop()
+		return nil, err
+	}
+
+	widget.proc = proc
+
+	return proc, nil
+}
+
+// size returns the space the plugin has to render in
+func (widget *Widget) size() (int, int) {
+	widget.m.Lock()
+	defer widget.m.Unlock()
+
+	return widget.settings.width, widget.settings.height
+}
+
+// sendKey forwards a key event to the plugin, which answers with a render message
+func (widget *Widget) sendKey(key string) {
+	widget.m.Lock()
+	proc := widget.proc
+	widget.m.Unlock()
+
+	if proc == nil {
+		return
+	}
+
+	err := proc.send(hostMessage{Type: msgKey, Key: key})
+	if err != nil {
+		widget.Logger().Warnf("could not send key %q to plugin: %s", key, err)
+	}
+}
+
+// stopped is called when the plugin process exits. If that wasn't because the widget was
+// stopped it's a refresh failure, and the plugin is relaunched on the next refresh
+func (widget *Widget) stopped(err error) {
+	if !widget.Enabled() {
+		return
+	}
+
+	widget.Logger().Warn(err.Error())
+	widget.SetRefreshError(err)
+	widget.display()
+}
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+const pluginConfig = `
+wtf:
+  mods:
+    stub:
+      type: plugin
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`
+
+// stubPlugin renders "refreshed" on refresh and "pressed" on key events, and exits when
+// it's sent the "q" key
+const stubPlugin = `printf '{"type":"ready","version":1,"keys":[{"key":"p","help":"Press"}]}\n'
+while read -r line; do
+	case "$line" in
+	*'"type":"refresh"'*) printf '{"type":"render","title":"Stub","content":"refreshed"}\n' ;;
+	*'"key":"q"'*) exit 3 ;;
+	*'"type":"key"'*) printf '{"type":"render","title":"Stub","content":"pressed"}\n' ;;
+	esac
+done`
+
+func newTestWidget(t *testing.T, script string) *Widget {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell available")
+	}
+
+	globalConfig, err := config.ParseYaml(pluginConfig)
+	assert.NoError(t, err)
+
+	moduleConfig, _ := globalConfig.Get("wtf.mods.stub")
+	settings := NewSettingsFromYAML("stub", moduleConfig, globalConfig)
+	settings.command = sh
+	settings.args = []string{"-c", script}
+
+	redrawChan := make(chan bool, 10)
+	go func() {
+		for range redrawChan {
+		}
+	}()
+
+	widget := NewWidget(tview.NewApplication(), redrawChan, tview.NewPages(), settings)
+	t.Cleanup(widget.Stop)
+
+	return widget
+}
+
+func content(widget *Widget) string {
+	_, content, _ := widget.contents()
+	return content
+}
+
+func Test_PluginLifecycle(t *testing.T) {
+	widget := newTestWidget(t, stubPlugin)
+
+	widget.Refresh()
+	assert.Eventually(t, func() bool { return content(widget) == "refreshed" }, 5*time.Second, 10*time.Millisecond)
+
+	title, _, _ := widget.contents()
+	assert.Equal(t, "Stub", title)
+	assert.NoError(t, widget.RefreshError())
+
+	widget.sendKey("p")
+	assert.Eventually(t, func() bool { return content(widget) == "pressed" }, 5*time.Second, 10*time.Millisecond)
+
+	widget.sendKey("q")
+	assert.Eventually(t, func() bool { return widget.RefreshError() != nil }, 5*time.Second, 10*time.Millisecond)
+
+	// The next refresh relaunches the plugin
+	widget.Refresh()
+	assert.Eventually(t, func() bool { return widget.RefreshError() == nil }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "refreshed", content(widget))
+}
+
+func Test_sendKeyToStuckPlugin(t *testing.T) {
+	widget := newTestWidget(t, "sleep 30")
+
+	widget.Refresh()
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			widget.sendKey("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx")
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sending keys to a plugin that isn't reading blocked")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 // Client pocket client Documention at https://getpocket.com/developer/docs/overview
@@ -88,7 +90,7 @@ func (*Client) request(req request, result interface{}) error {
 	}
 	request.Header.Set("User-Agent", "wtfutil (https://github.com/wtfutil/wtf)")
 
-	resp, err := http.DefaultClient.Do(request)
+	resp, err := httpclient.Client().Do(request)
 
 	if err != nil {
 		return err
@@ -6,7 +6,6 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
-	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"gopkg.in/yaml.v2"
@@ -61,6 +60,7 @@ func (widget *Widget) Refresh() {
 		state = Read
 	}
 	response, err := widget.client.GetLinks(state)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.SetItemCount(0)
 	}
@@ -131,7 +131,7 @@ func (widget *Widget) authorizeWorkFlow() (string, string, bool) {
 		requestToken, err := widget.client.ObtainRequestToken()
 
 		if err != nil {
-			logger.Log(err.Error())
+			widget.Logger().Error(err.Error())
 			return title, err.Error(), true
 		}
 		widget.settings.requestKey = &requestToken
@@ -143,7 +143,7 @@ func (widget *Widget) authorizeWorkFlow() (string, string, bool) {
 	if widget.settings.accessToken == nil {
 		accessToken, err := widget.client.GetAccessToken(*widget.settings.requestKey)
 		if err != nil {
-			logger.Log(err.Error())
+			widget.Logger().Error(err.Error())
 			redirectURL := widget.client.CreateAuthLink(*widget.settings.requestKey)
 			content := fmt.Sprintf("Please click on %s to Authorize the app", redirectURL)
 			return title, content, true
@@ -192,7 +192,7 @@ func (widget *Widget) toggleLink() {
 		item := &widget.items[sel]
 		_, err := widget.client.ModifyLink(action, item.ItemID)
 		if err != nil {
-			logger.Log(err.Error())
+			widget.Logger().Error(err.Error())
 		}
 	}
 
@@ -208,7 +208,7 @@ func (widget *Widget) formatItem(item Item, isSelected bool) string {
 
 	}
 
-	return fmt.Sprintf("[%s:%s]%s[white]", foreColor, backColor, tview.Escape(text))
+	return fmt.Sprintf("[%s:%s]%s[-]", foreColor, backColor, tview.Escape(text))
 }
 
 func (widget *Widget) content() (string, string, bool) {
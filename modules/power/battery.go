@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -20,15 +21,17 @@ type Battery struct {
 	args   []string
 	cmd    string
 	result string
+	roles  cfg.RoleTheme
 
 	Charge    string
 	Remaining string
 }
 
-func NewBattery() *Battery {
+func NewBattery(roles cfg.RoleTheme) *Battery {
 	battery := &Battery{
-		args: []string{"-g", "batt"},
-		cmd:  "pmset",
+		args:  []string{"-g", "batt"},
+		cmd:   "pmset",
+		roles: roles,
 	}
 
 	return battery
@@ -78,7 +81,7 @@ func (battery *Battery) parse(data string) string {
 
 func (battery *Battery) formatCharge(data string) string {
 	percent, _ := strconv.ParseFloat(strings.Replace(data, "%", "", -1), 32)
-	return utils.ColorizePercent(percent)
+	return utils.ColorizePercent(percent, battery.roles)
 }
 
 func (battery *Battery) formatRemaining(data string) string {
@@ -97,12 +100,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "charging":
-		color = "[green]"
+		color = "[" + battery.roles.Ok + "]"
 	case "discharging":
-		color = "[yellow]"
+		color = "[" + battery.roles.Warn + "]"
 	default:
-		color = "[white]"
+		color = "[-]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -17,13 +18,14 @@ type Battery struct {
 	args   []string
 	cmd    string
 	result string
+	roles  cfg.RoleTheme
 
 	Charge    string
 	Remaining string
 }
 
-func NewBattery() *Battery {
-	return &Battery{}
+func NewBattery(roles cfg.RoleTheme) *Battery {
+	return &Battery{roles: roles}
 }
 
 /* -------------------- Exported Functions -------------------- */
@@ -74,7 +76,7 @@ func (battery *Battery) parse(data string) string {
 
 func (battery *Battery) formatCharge(data string) string {
 	percent, _ := strconv.ParseFloat(strings.Replace(data, "%", "", -1), 32)
-	return utils.ColorizePercent(percent)
+	return utils.ColorizePercent(percent, battery.roles)
 }
 
 func (battery *Battery) formatState(data string) string {
@@ -82,12 +84,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "1":
-		color = "[green]charging"
+		color = "[" + battery.roles.Ok + "]charging"
 	case "0":
-		color = "[yellow]discharging"
+		color = "[" + battery.roles.Warn + "]discharging"
 	default:
-		color = "[white]unknown"
+		color = "[-]unknown"
 	}
 
-	return color + "[white]"
+	return color + "[-]"
 }
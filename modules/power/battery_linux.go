@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -15,13 +16,14 @@ var batteryState string
 
 type Battery struct {
 	result string
+	roles  cfg.RoleTheme
 
 	Charge    string
 	Remaining string
 }
 
-func NewBattery() *Battery {
-	return &Battery{}
+func NewBattery(roles cfg.RoleTheme) *Battery {
+	return &Battery{roles: roles}
 }
 
 /* -------------------- Exported Functions -------------------- */
@@ -79,7 +81,7 @@ func (battery *Battery) parse(data string) string {
 
 func (battery *Battery) formatCharge(data string) string {
 	percent, _ := strconv.ParseFloat(strings.ReplaceAll(data, "%", ""), 32)
-	return utils.ColorizePercent(percent)
+	return utils.ColorizePercent(percent, battery.roles)
 }
 
 func (battery *Battery) formatState(data string) string {
@@ -87,12 +89,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "charging":
-		color = "[green]"
+		color = "[" + battery.roles.Ok + "]"
 	case "discharging":
-		color = "[yellow]"
+		color = "[" + battery.roles.Warn + "]"
 	default:
-		color = "[white]"
+		color = "[-]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
@@ -27,7 +27,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 	widget := Widget{
 		TextWidget: view.NewTextWidget(tviewApp, redrawChan, nil, settings.Common),
 
-		Battery:        NewBattery(),
+		Battery:        NewBattery(settings.Colors.RoleTheme),
 		ManagedDevices: NewManagedDevices(),
 
 		settings: settings,
@@ -69,7 +69,7 @@ func (widget *Widget) content() (string, string, bool) {
 
 	for _, manDev := range widget.ManagedDevices.Devices {
 		if manDev.HasBattery() {
-			percent := utils.ColorizePercent(float64(manDev.BatteryPercent()))
+			percent := utils.ColorizePercent(float64(manDev.BatteryPercent()), widget.settings.Colors.RoleTheme)
 
 			prodName := manDev.Product()
 
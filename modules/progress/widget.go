@@ -60,6 +60,8 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 func (widget *Widget) Refresh() {
 	var err error
 
+	widget.err = nil
+
 	if cmd := widget.settings.minimumCmd; cmd != "" {
 		widget.minimum, err = widget.execValueCmd(cmd)
 		if err != nil {
@@ -95,8 +97,9 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() string {
+	widget.SetRefreshError(widget.err)
 	if widget.err != nil {
-		return "[red]Error: " + widget.err.Error()
+		return "[" + widget.settings.common.Colors.RoleTheme.Error + "]Error: " + widget.err.Error()
 	}
 
 	percent := widget.formatPercent(widget.percent)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -44,6 +45,7 @@ func (widget *Widget) Refresh() {
 		widget.settings.activeOnly,
 	)
 
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.items = nil
@@ -75,14 +77,16 @@ func (widget *Widget) content() (string, string, bool) {
 	if len(result.Items) > widget.settings.count {
 		result.Items = result.Items[:widget.settings.count]
 	}
+	roles := widget.settings.Colors.RoleTheme
+
 	for idx, item := range result.Items {
 
 		row := fmt.Sprintf(
 			"[%s] [%s] %s [%s] %s [%s]count: %d [%s]%s",
 			widget.RowColor(idx),
-			levelColor(&item),
+			levelColor(&item, roles),
 			item.Level,
-			statusColor(&item),
+			statusColor(&item, roles),
 			item.Title,
 			widget.RowColor(idx),
 			item.TotalOccurrences,
@@ -95,26 +99,26 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
-func statusColor(item *Item) string {
+func statusColor(item *Item, roles cfg.RoleTheme) string {
 	switch item.Status {
 	case "active":
-		return "red"
+		return roles.Error
 	case "resolved":
-		return "green"
+		return roles.Ok
 	default:
-		return "red"
+		return roles.Error
 	}
 }
-func levelColor(item *Item) string {
+func levelColor(item *Item, roles cfg.RoleTheme) string {
 	switch item.Level {
 	case "error":
-		return "red"
+		return roles.Error
 	case "critical":
-		return "green"
+		return roles.Error
 	case "warning":
-		return "yellow"
+		return roles.Warn
 	default:
-		return "grey"
+		return roles.Muted
 	}
 }
 
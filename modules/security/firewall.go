@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -14,14 +15,14 @@ const osxFirewallCmd = "/usr/libexec/ApplicationFirewall/socketfilterfw"
 
 /* -------------------- Exported Functions -------------------- */
 
-func FirewallState() string {
+func FirewallState(roles cfg.RoleTheme) string {
 	switch runtime.GOOS {
 	case "darwin":
 		return firewallStateMacOS()
 	case "linux":
-		return firewallStateLinux()
+		return firewallStateLinux(roles)
 	case "windows":
-		return firewallStateWindows()
+		return firewallStateWindows(roles)
 	default:
 		return ""
 	}
@@ -42,7 +43,7 @@ func FirewallStealthState() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func firewallStateLinux() string { // might be very Ubuntu specific
+func firewallStateLinux(roles cfg.RoleTheme) string { // might be very Ubuntu specific
 	user, _ := user.Current()
 
 	if strings.Contains(user.Username, "root") {
@@ -51,16 +52,16 @@ func firewallStateLinux() string { // might be very Ubuntu specific
 		var o bytes.Buffer
 		cmd.Stdout = &o
 		if err := cmd.Run(); err != nil {
-			return "[red]NA[white]"
+			return "[" + roles.Error + "]NA[-]"
 		}
 
 		if strings.Contains(o.String(), "inactive") {
-			return "[red]Disabled[white]"
+			return "[" + roles.Error + "]Disabled[-]"
 		} else {
-			return "[green]Enabled[white]"
+			return "[" + roles.Ok + "]Enabled[-]"
 		}
 	} else {
-		return "[red]N/A[white]"
+		return "[" + roles.Error + "]N/A[-]"
 	}
 }
 
@@ -71,7 +72,7 @@ func firewallStateMacOS() string {
 	return statusLabel(str)
 }
 
-func firewallStateWindows() string {
+func firewallStateWindows(roles cfg.RoleTheme) string {
 	// The raw way to do this in PS, not using netsh, nor registry, is the following:
 	//   if (((Get-NetFirewallProfile | select name,enabled)
 	//                                | where { $_.Enabled -eq $True } | measure ).Count -eq 3)
@@ -85,15 +86,15 @@ func firewallStateWindows() string {
 
 	switch fwStat {
 	case "3":
-		return "[green]Good[white] (3/3)"
+		return "[" + roles.Ok + "]Good[-] (3/3)"
 	case "2":
-		return "[orange]Poor[white] (2/3)"
+		return "[orange]Poor[-] (2/3)"
 	case "1":
-		return "[yellow]Bad[white] (1/3)"
+		return "[" + roles.Warn + "]Bad[-] (1/3)"
 	case "0":
-		return "[red]Disabled[white]"
+		return "[" + roles.Error + "]Disabled[-]"
 	default:
-		return "[white]N/A[white]"
+		return "[-]N/A[-]"
 	}
 }
 
@@ -101,7 +102,7 @@ func firewallStateWindows() string {
 // "Stealth": Not responding to pings from unauthorized devices
 
 func firewallStealthStateLinux() string {
-	return "[white]N/A[white]"
+	return "[-]N/A[-]"
 }
 
 func firewallStealthStateMacOS() string {
@@ -112,7 +113,7 @@ func firewallStealthStateMacOS() string {
 }
 
 func firewallStealthStateWindows() string {
-	return "[white]N/A[white]"
+	return "[-]N/A[-]"
 }
 
 func statusLabel(str string) string {
@@ -1,5 +1,7 @@
 package security
 
+import "github.com/wtfutil/wtf/cfg"
+
 type SecurityData struct {
 	Dns             []string
 	FirewallEnabled string
@@ -20,9 +22,10 @@ func (data SecurityData) DnsAt(idx int) string {
 	return ""
 }
 
-func (data *SecurityData) Fetch() {
+// Fetch gathers the security data, coloring the firewall's state with the theme's roles
+func (data *SecurityData) Fetch(roles cfg.RoleTheme) {
 	data.Dns = DnsServers()
-	data.FirewallEnabled = FirewallState()
+	data.FirewallEnabled = FirewallState(roles)
 	data.FirewallStealth = FirewallStealthState()
 	data.LoggedInUsers = LoggedInUsers()
 	data.WifiName = WifiName()
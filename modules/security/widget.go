@@ -39,26 +39,26 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	data := NewSecurityData()
-	data.Fetch()
+	data.Fetch(widget.settings.Colors.RoleTheme)
 	var str string
 
 	if data.WifiName != "" {
-		str += fmt.Sprintf(" [%s]WiFi[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf(" [%s]WiFi[-]\n", widget.settings.Colors.Subheading)
 		str += fmt.Sprintf(" %8s: %s\n", "Network", data.WifiName)
 		str += fmt.Sprintf(" %8s: %s\n", "Crypto", data.WifiEncryption)
 		str += "\n"
 	}
 
-	str += fmt.Sprintf(" [%s]Firewall[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Firewall[-]\n", widget.settings.Colors.Subheading)
 	str += fmt.Sprintf(" %8s: %4s\n", "Status", data.FirewallEnabled)
 	str += fmt.Sprintf(" %8s: %4s\n", "Stealth", data.FirewallStealth)
 	str += "\n"
 
-	str += fmt.Sprintf(" [%s]Users[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Users[-]\n", widget.settings.Colors.Subheading)
 	str += fmt.Sprintf("  %s", strings.Join(data.LoggedInUsers, "\n  "))
 	str += "\n\n"
 
-	str += fmt.Sprintf(" [%s]DNS[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]DNS[-]\n", widget.settings.Colors.Subheading)
 	str += fmt.Sprintf("  %12s\n", data.DnsAt(0))
 	str += fmt.Sprintf("  %12s\n", data.DnsAt(1))
 	str += "\n"
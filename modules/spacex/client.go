@@ -1,8 +1,7 @@
 package spacex
 
 import (
-	"net/http"
-
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -35,7 +34,7 @@ type Links struct {
 }
 
 func NextLaunch() (*Launch, error) {
-	resp, err := http.Get(spacexLaunchAPI)
+	resp, err := httpclient.Client().Get(spacexLaunchAPI)
 	if err != nil {
 		return nil, err
 	}
@@ -41,24 +41,24 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 
 	launch, err := NextLaunch()
+	handleError(widget, err)
+
 	var str string
-	if err != nil {
-		handleError(widget, err)
-	} else {
+	if err == nil {
 
-		str = fmt.Sprintf("[%s]Mission[white]\n", widget.settings.Colors.Subheading)
+		str = fmt.Sprintf("[%s]Mission[-]\n", widget.settings.Colors.Subheading)
 		str += fmt.Sprintf("%s: %s\n", "Name", launch.MissionName)
 		str += fmt.Sprintf("%s: %s\n", "Date", wtf.UnixTime(launch.LaunchDate).Format(time.RFC822))
 		str += fmt.Sprintf("%s: %s\n", "Site", launch.LaunchSite.Name)
 		str += "\n"
 
-		str += fmt.Sprintf("[%s]Links[white]\n", widget.settings.Colors.Subheading)
+		str += fmt.Sprintf("[%s]Links[-]\n", widget.settings.Colors.Subheading)
 		str += fmt.Sprintf("%s: %s\n", "YouTube", launch.Links.YouTubeLink)
 		str += fmt.Sprintf("%s: %s\n", "Reddit", launch.Links.RedditLink)
 
 		if widget.CommonSettings().Height >= 2 {
 			str += "\n"
-			str += fmt.Sprintf("[%s]Details[white]\n", widget.settings.Colors.Subheading)
+			str += fmt.Sprintf("[%s]Details[-]\n", widget.settings.Colors.Subheading)
 			str += fmt.Sprintf("%s: %s\n", "RocketName", launch.Rocket.Name)
 			str += fmt.Sprintf("%s: %s\n", "Details", launch.Details)
 		}
@@ -68,4 +68,5 @@ func (widget *Widget) content() (string, string, bool) {
 
 func handleError(widget *Widget, err error) {
 	widget.err = err
+	widget.SetRefreshError(err)
 }
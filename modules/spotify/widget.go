@@ -54,6 +54,7 @@ func (w *Widget) Refresh() {
 func (w *Widget) createOutput() (string, string, bool) {
 	var content string
 	err := w.refreshSpotifyInfos()
+	w.SetRefreshError(err)
 	if err != nil {
 		content = err.Error()
 	} else {
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/rivo/tview"
@@ -37,6 +36,7 @@ type Widget struct {
 
 	Info
 
+	authErr     error
 	client      *spotify.Client
 	clientChan  chan *spotify.Client
 	playerState *spotify.PlayerState
@@ -96,14 +96,13 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 		// use the client to make calls that require authorization
 		_, err := client.CurrentUser()
-		if err != nil {
-			panic(err)
+		if err == nil {
+			playerState, err = client.PlayerState()
 		}
-
-		playerState, err = client.PlayerState()
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			widget.authErr = fmt.Errorf("authentication failed: %w", err)
+			widget.Refresh()
+			return
 		}
 
 		widget.client = client
@@ -131,13 +130,16 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 }
 
 func (w *Widget) refreshSpotifyInfos() error {
+	if w.authErr != nil {
+		return w.authErr
+	}
 	if w.client == nil || w.playerState == nil {
 		return errors.New("authentication failed! Please log in to Spotify by visiting the following page in your browser: " + authURL)
 	}
 	var err error
 	w.playerState, err = w.client.PlayerState()
 	if err != nil {
-		return errors.New("extracting player state failed! Please refresh or restart WTF")
+		return fmt.Errorf("extracting player state failed! Please refresh or restart WTF: %w", err)
 	}
 	w.Info.Album = fmt.Sprint(w.playerState.CurrentlyPlaying.Item.Album.Name)
 	artists := ""
@@ -165,17 +167,20 @@ func (w *Widget) createOutput() (string, string, bool) {
 	var output string
 
 	err := w.refreshSpotifyInfos()
+	w.SetRefreshError(err)
 	if err != nil {
 		output = err.Error()
 	} else {
-		output += utils.CenterText(fmt.Sprintf("[green]Now %v [white]\n", w.Info.Status), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Title:[white] %v\n", w.Info.Title), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Artist:[white] %v\n", w.Info.Artists), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Album:[white] %v\n", w.Info.Album), w.CommonSettings().Width)
+		label := w.settings.Colors.Label
+
+		output += utils.CenterText(fmt.Sprintf("[%s]Now %v [-]\n", w.settings.Colors.RoleTheme.Ok, w.Info.Status), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Title:[-] %v\n", label, w.Info.Title), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Artist:[-] %v\n", label, w.Info.Artists), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Album:[-] %v\n", label, w.Info.Album), w.CommonSettings().Width)
 		if w.playerState.ShuffleState {
-			output += utils.CenterText("[green]Shuffle:[white] on\n", w.CommonSettings().Width)
+			output += utils.CenterText(fmt.Sprintf("[%s]Shuffle:[-] on\n", label), w.CommonSettings().Width)
 		} else {
-			output += utils.CenterText("[green]Shuffle:[white] off\n", w.CommonSettings().Width)
+			output += utils.CenterText(fmt.Sprintf("[%s]Shuffle:[-] off\n", label), w.CommonSettings().Width)
 		}
 	}
 	return w.CommonSettings().Title, output, true
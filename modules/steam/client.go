@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 type Steam struct {
@@ -27,7 +29,7 @@ func NewClient(opts *ClientOpts) *Steam {
 	baseUrl += opts.key + "&steamids="
 
 	return &Steam{
-		client:  &http.Client{},
+		client:  httpclient.Client(),
 		baseUrl: baseUrl,
 	}
 }
@@ -61,7 +63,7 @@ func (s *Steam) Status(steamID string) (*Player, error) {
 }
 
 func (s *Steam) fetch(id string) ([]byte, error) {
-	resp, err := http.Get(s.baseUrl + id)
+	resp, err := s.client.Get(s.baseUrl + id)
 
 	if err != nil || resp.StatusCode != 200 {
 		return nil, fmt.Errorf("error fetching %s steam status: %v, status: %d", id, err, resp.StatusCode)
@@ -62,6 +62,7 @@ func (widget *Widget) Refresh() {
 		}
 		widget.SetItemCount(len(widget.players))
 	}
+	widget.SetRefreshError(widget.err)
 
 	widget.Render()
 }
@@ -107,17 +108,20 @@ func (widget *Widget) content() (string, string, bool) {
 
 	var str string
 
+	roles := widget.settings.Colors.RoleTheme
+
 	for idx, player := range widget.players {
 		status := friendlyStatus(player.Personastate)
 
 		row := fmt.Sprintf(
-			"[white]%s: [yellow]%s",
+			"[-]%s: [%s]%s",
 			player.Personaname,
+			roles.Warn,
 			status,
 		)
 
 		if len(player.Gameextrainfo) > 0 {
-			row += " [red](" + player.Gameextrainfo + ")"
+			row += fmt.Sprintf(" [%s](%s)", roles.Accent, player.Gameextrainfo)
 		}
 
 		str += utils.HighlightableHelper(widget.View, row, idx, len(player.Personaname))
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"github.com/wtfutil/wtf/httpclient"
 )
 
 // Client ..
@@ -65,7 +67,7 @@ func (client *Client) finnhubRequest(symbol string) (*http.Response, error) {
 		return nil, err
 	}
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -42,6 +42,7 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	quotes, err := widget.Client.Getquote()
+	widget.SetRefreshError(err)
 
 	title := widget.CommonSettings().Title
 	t := table.NewWriter()
@@ -1,10 +1,10 @@
 package subreddit
 
 import (
-	"crypto/tls"
 	"fmt"
 	"net/http"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -24,11 +24,7 @@ func GetLinks(subreddit string, sortMode string, topTimePeriod string) ([]Link,
 	request.Header.Set("User-Agent", "wtfutil (https://github.com/wtfutil/wtf)")
 
 	// See https://www.reddit.com/r/redditdev/comments/t8e8hc/comment/i18yga2/?utm_source=share&utm_medium=web2x&context=3
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSNextProto: map[string]func(authority string, c *tls.Conn) http.RoundTripper{},
-		},
-	}
+	client := httpclient.HTTP1Client()
 	resp, err := client.Do(request)
 
 	if err != nil {
@@ -34,6 +34,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 func (widget *Widget) Refresh() {
 	links, err := GetLinks(widget.settings.subreddit, widget.settings.sortOrder, widget.settings.topTimePeriod)
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.links = nil
@@ -63,7 +63,7 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf(
-		"[%s]%s[white]",
+		"[%s]%s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		widget.CurrentSource(),
 	)
@@ -25,8 +25,8 @@ func (widget *Widget) content() (string, string, bool) {
 		str, hidden = widget.sortListByChecked(widget.list.Items, []*checklist.ChecklistItem{})
 	}
 
-	if widget.Error != "" {
-		str = widget.Error
+	if err := widget.RefreshError(); err != nil {
+		str = err.Error()
 	}
 
 	title := widget.CommonSettings().Title
@@ -147,14 +147,14 @@ func (widget *Widget) formattedItemLine(idx int, hidden int, currItem *checklist
 	tagsPart := ""
 	if len(currItem.Tags) > 0 {
 		tagsPart = fmt.Sprintf(
-			`[%s]%s[white]`,
+			`[%s]%s[-]`,
 			widget.settings.tagColor,
 			currItem.TagString(),
 		)
 	}
 
 	textPart := fmt.Sprintf(
-		`[%s]%s[white]`,
+		`[%s]%s[-]`,
 		rowColor,
 		tview.Escape(currItem.Text),
 	)
@@ -12,6 +12,7 @@ import (
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/checklist"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"github.com/wtfutil/wtf/wtf"
@@ -33,7 +34,6 @@ type Widget struct {
 	showTagPrefix string
 	showFilter    string
 	tviewApp      *tview.Application
-	Error         string
 
 	view.ScrollableWidget
 
@@ -81,11 +81,13 @@ func (widget *Widget) SelectedItem() *checklist.ChecklistItem {
 
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
-	widget.Error = ""
 	err := widget.load()
-	if err != nil {
-		widget.Error = err.Error()
+	widget.SetRefreshError(err)
+
+	if err == nil {
+		widget.RaiseEvents(widget.dueEvents())
 	}
+
 	widget.display()
 }
 
@@ -142,6 +144,39 @@ func (widget *Widget) load() error {
 	return nil
 }
 
+// dueEvents returns an event for each unchecked item that's due today or overdue
+func (widget *Widget) dueEvents() []notify.Event {
+	events := []notify.Event{}
+	if !widget.settings.parseDates {
+		return events
+	}
+
+	today := getNowDate()
+
+	for _, item := range widget.list.Items {
+		if item.Checked || item.Date == nil || item.Date.After(today) {
+			continue
+		}
+
+		event := notify.Event{
+			Key:      item.Text,
+			Kind:     "due",
+			Message:  "Due today",
+			Severity: notify.SeverityWarn,
+			Title:    item.Text,
+		}
+
+		if item.Date.Before(today) {
+			event.Message = "Overdue since " + item.Date.Format("2006-01-02")
+			event.Severity = notify.SeverityError
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
 func (widget *Widget) newItem() {
 	widget.processFormInput("New Todo:", "", func(t string) {
 		text, date, tags := widget.getTextComponents(t)
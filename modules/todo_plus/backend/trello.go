@@ -2,7 +2,6 @@ package backend
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/adlio/trello"
 	"github.com/olebedev/config"
@@ -27,11 +26,6 @@ func (todo *Trello) Setup(config *config.Config) {
 		config.UString("apiKey"),
 		config.UString("accessToken"),
 	)
-	board, err := getBoardID(todo.client, todo.username, todo.boardName)
-	if err != nil {
-		log.Fatal(err)
-	}
-	todo.board = board
 	todo.projects = config.UList("lists")
 }
 
@@ -105,6 +99,17 @@ func (todo *Trello) GetProject(id string) *Project {
 		backend: todo,
 	}
 
+	// The board is looked up on first use, so that failing to reach Trello is reported
+	// on the widget and retried on the next refresh
+	if todo.board == "" {
+		board, err := getBoardID(todo.client, todo.username, todo.boardName)
+		if err != nil {
+			proj.Err = err
+			return proj
+		}
+		todo.board = board
+	}
+
 	listId, err := getListId(todo.client, todo.board, id)
 	if err != nil {
 		proj.Err = err
@@ -19,7 +19,7 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 
 	title := fmt.Sprintf(
-		"[%s]%s[white]",
+		"[%s]%s[-]",
 		widget.settings.Colors.TextTheme.Title,
 		proj.Name)
 
@@ -74,6 +74,16 @@ func (widget *Widget) Refresh() {
 
 	widget.projects = widget.backend.BuildProjects()
 	widget.Sources = widget.backend.Sources()
+
+	var err error
+	for _, proj := range widget.projects {
+		if proj.Err != nil {
+			err = proj.Err
+			break
+		}
+	}
+	widget.SetRefreshError(err)
+
 	widget.SetItemCount(len(widget.CurrentProject().Tasks))
 	widget.display()
 }
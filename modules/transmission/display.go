@@ -32,7 +32,7 @@ func (widget *Widget) content() (string, string, bool) {
 		torrName := *torrent.Name
 
 		row := fmt.Sprintf(
-			"[%s] %s %s %s%s[white]",
+			"[%s] %s %s %s%s[-]",
 			widget.RowColor(idx),
 			widget.torrentPercentDone(torrent),
 			widget.torrentSeedRatio(torrent),
@@ -61,12 +61,12 @@ func (widget *Widget) torrentPercentDone(torrent transmissionrpc.Torrent) string
 	case 0.0:
 		str = "[gray::b]" + str
 	case 1.0:
-		str = "[green::b]" + str
+		str = "[" + widget.settings.Colors.RoleTheme.Ok + "::b]" + str
 	default:
 		str = "[lightblue::b]" + str
 	}
 
-	return str + "[white]"
+	return str + "[-]"
 }
 
 func (widget *Widget) torrentSeedRatio(torrent transmissionrpc.Torrent) string {
@@ -76,7 +76,7 @@ func (widget *Widget) torrentSeedRatio(torrent transmissionrpc.Torrent) string {
 		seedRatio = 0
 	}
 
-	return fmt.Sprintf("[green]%3d%%↑", int(seedRatio*100))
+	return fmt.Sprintf("[%s]%3d%%↑", widget.settings.Colors.RoleTheme.Ok, int(seedRatio*100))
 }
 
 func (widget *Widget) torrentState(torrent transmissionrpc.Torrent) string {
@@ -88,7 +88,7 @@ func (widget *Widget) torrentState(torrent transmissionrpc.Torrent) string {
 	case transmissionrpc.TorrentStatusDownload:
 		str += "[lightblue]"
 	case transmissionrpc.TorrentStatusSeed:
-		str += "[green]"
+		str += "[" + widget.settings.Colors.RoleTheme.Ok + "]"
 	}
 
 	return str
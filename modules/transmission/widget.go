@@ -73,6 +73,8 @@ func (widget *Widget) Refresh() {
 		count = len(torrents)
 	}
 
+	widget.SetRefreshError(err)
+
 	widget.mu.Lock()
 	widget.err = err
 	widget.torrents = torrents
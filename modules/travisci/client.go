@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -62,7 +63,7 @@ func travisBuildRequest(settings *Settings) (*http.Response, error) {
 	bearer := fmt.Sprintf("token %s", settings.apiKey)
 	req.Header.Add("Authorization", bearer)
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.Client()
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
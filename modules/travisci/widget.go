@@ -39,6 +39,7 @@ func (widget *Widget) Refresh() {
 
 	builds, err := BuildsFor(widget.settings)
 
+	widget.SetRefreshError(err)
 	if err != nil {
 		widget.err = err
 		widget.builds = nil
@@ -63,7 +64,7 @@ func (widget *Widget) content() (string, string, bool) {
 	if widget.err != nil {
 		str = widget.err.Error()
 	} else {
-		var rowFormat = "[%s] [%s] %s-%s (%s) [%s]%s - [blue]%s"
+		var rowFormat = "[%s] [%s] %s-%s (%s) [%s]%s - [%s]%s"
 		if !widget.settings.compact {
 			rowFormat += "\n"
 		}
@@ -72,12 +73,13 @@ func (widget *Widget) content() (string, string, bool) {
 			row := fmt.Sprintf(
 				rowFormat,
 				widget.RowColor(idx),
-				buildColor(build),
+				widget.buildColor(build),
 				build.Repository.Name,
 				build.Number,
 				build.Branch.Name,
 				widget.RowColor(idx),
 				strings.Split(build.Commit.Message, "\n")[0],
+				widget.settings.Colors.RoleTheme.Accent,
 				build.CreatedBy.Login,
 			)
 			str += utils.HighlightableHelper(widget.View, row, idx, len(build.Branch.Name))
@@ -87,24 +89,26 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
-func buildColor(build Build) string {
+func (widget *Widget) buildColor(build Build) string {
+	roles := widget.settings.Colors.RoleTheme
+
 	switch build.State {
 	case "broken":
-		return "red"
+		return roles.Error
 	case "failed":
-		return "red"
+		return roles.Error
 	case "failing":
-		return "red"
+		return roles.Error
 	case "pending":
-		return "yellow"
+		return roles.Warn
 	case "started":
-		return "yellow"
+		return roles.Warn
 	case "fixed":
-		return "green"
+		return roles.Ok
 	case "passed":
-		return "green"
+		return roles.Ok
 	default:
-		return "white"
+		return "-"
 	}
 }
 
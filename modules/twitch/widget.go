@@ -88,6 +88,7 @@ func (widget *Widget) Refresh() {
 		streams := makeStreams(response)
 		widget.topStreams = streams
 		widget.err = nil
+		widget.SetRefreshError(nil)
 		if len(streams) <= widget.settings.numberOfResults {
 			widget.SetItemCount(len(widget.topStreams))
 		} else {
@@ -119,6 +120,7 @@ func makeStreams(response *helix.StreamsResponse) []*Stream {
 
 func handleError(widget *Widget, err error) {
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.topStreams = nil
 	widget.SetItemCount(0)
 }
@@ -140,9 +142,10 @@ func (widget *Widget) content() (string, string, bool) {
 
 	for idx, stream := range widget.topStreams {
 		row := fmt.Sprintf(
-			"[%s]%2d. [red]%s [white]%s - %s",
+			"[%s]%2d. [%s]%s [-]%s - %s",
 			widget.RowColor(idx),
 			idx+1,
+			widget.settings.Colors.RoleTheme.Accent,
 			utils.PrettyNumber(locPrinter, float64(stream.ViewerCount)),
 			stream.Streamer,
 			stream.Title,
@@ -56,13 +56,13 @@ func NewClient(settings *Settings) *Client {
 /* -------------------- Public Functions -------------------- */
 
 // Tweets returns a list of tweets of a user
-func (client *Client) Tweets() []Tweet {
+func (client *Client) Tweets() ([]Tweet, error) {
 	tweets, err := client.getTweets()
 	if err != nil {
-		return []Tweet{}
+		return []Tweet{}, err
 	}
 
-	return tweets
+	return tweets, nil
 }
 
 /* -------------------- Private Functions -------------------- */
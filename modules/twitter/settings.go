@@ -33,7 +33,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		screenNames:    ymlConfig.UList("screenName"),
 	}
 
-	settings.SetDocumentationPath("twitter/tweets")
-
 	return &settings
 }
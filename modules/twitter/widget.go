@@ -53,12 +53,17 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	widget.client.screenName = widget.CurrentSource()
-	tweets := widget.client.Tweets()
+	tweets, err := widget.client.Tweets()
+	widget.SetRefreshError(err)
 
-	title := fmt.Sprintf("Twitter - [green]@%s[white]", widget.CurrentSource())
+	title := fmt.Sprintf("Twitter - [%s]@%s[-]", widget.settings.Colors.RoleTheme.Accent, widget.CurrentSource())
+
+	if err != nil {
+		return title, err.Error(), true
+	}
 
 	if len(tweets) == 0 {
-		str := fmt.Sprintf("\n\n\n%s", utils.CenterText("[lightblue]No Tweets[white]", 50))
+		str := fmt.Sprintf("\n\n\n%s", utils.CenterText("[lightblue]No Tweets[-]", 50))
 		return title, str, true
 	}
 
@@ -88,19 +93,19 @@ func (widget *Widget) formatText(text string) string {
 
 	// RT indicator
 	rtRegExp := regexp.MustCompile(`^RT`)
-	result = rtRegExp.ReplaceAllString(result, "[olive]${0}[white::-]")
+	result = rtRegExp.ReplaceAllString(result, "[olive]${0}[-::-]")
 
 	// @name mentions
 	atRegExp := regexp.MustCompile(`@[0-9A-Za-z_]*`)
-	result = atRegExp.ReplaceAllString(result, "[lightblue]${0}[white]")
+	result = atRegExp.ReplaceAllString(result, "[lightblue]${0}[-]")
 
 	// HTTP(S) links
 	linkRegExp := regexp.MustCompile(`http[s:\/.0-9A-Za-z]*`)
-	result = linkRegExp.ReplaceAllString(result, "[lightblue::u]${0}[white::-]")
+	result = linkRegExp.ReplaceAllString(result, "[lightblue::u]${0}[-::-]")
 
 	// Hash tags
 	hashRegExp := regexp.MustCompile(`#[0-9A-Za-z_]*`)
-	result = hashRegExp.ReplaceAllString(result, "[yellow]${0}[white]")
+	result = hashRegExp.ReplaceAllString(result, "["+widget.settings.Colors.RoleTheme.Highlight+"]${0}[-]")
 
 	return result
 }
@@ -120,7 +125,7 @@ func (widget *Widget) format(tweet Tweet) string {
 		)
 	}
 
-	return fmt.Sprintf("%s\n[grey]%s[white]\n\n", body, attribution)
+	return fmt.Sprintf("%s\n[grey]%s[-]\n\n", body, attribution)
 }
 func (widget *Widget) currentSourceURI() string {
 
@@ -65,8 +65,8 @@ func NewClient(settings *Settings) *Client {
 }
 
 // GetStatsForUser Fetches stats for a single user.  If there is an error fetching or parsing the response
-// from the Twitter API, an empty stats struct will be returned.
-func (client *Client) GetStatsForUser(username string) TwitterStats {
+// from the Twitter API, an empty stats struct will be returned along with the error.
+func (client *Client) GetStatsForUser(username string) (TwitterStats, error) {
 	stats := TwitterStats{
 		FollowerCount: 0,
 		TweetCount:    0,
@@ -75,32 +75,40 @@ func (client *Client) GetStatsForUser(username string) TwitterStats {
 	url := fmt.Sprintf("%s?screen_name=%s", userTimelineURL, username)
 	resp, err := client.httpClient.Get(url)
 	if err != nil {
-		return stats
+		return stats, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode != http.StatusOK {
+		return stats, fmt.Errorf("fetching stats for %s: %s", username, resp.Status)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return stats
+		return stats, err
 	}
 
-	// If there is an error while parsing, just discard the error and return the empty stats
 	err = json.Unmarshal(body, &stats)
 	if err != nil {
-		return stats
+		return TwitterStats{}, err
 	}
 
-	return stats
+	return stats, nil
 }
 
 // GetStats Returns a slice of `TwitterStats` structs for each username in `client.screenNames` in the same
-// order of `client.screenNames`
-func (client *Client) GetStats() []TwitterStats {
+// order of `client.screenNames`, along with the first error encountered fetching them
+func (client *Client) GetStats() ([]TwitterStats, error) {
 	stats := make([]TwitterStats, len(client.screenNames))
 
+	var err error
 	for i, username := range client.screenNames {
-		stats[i] = client.GetStatsForUser(username)
+		var userErr error
+		stats[i], userErr = client.GetStatsForUser(username)
+		if userErr != nil && err == nil {
+			err = userErr
+		}
 	}
 
-	return stats
+	return stats, err
 }
@@ -36,14 +36,18 @@ func (widget *Widget) Refresh() {
 func (widget *Widget) content() (string, string, bool) {
 	// Add header row
 	str := fmt.Sprintf(
-		"[%s]%-12s %10s %8s[white]\n",
+		"[%s]%-12s %10s %8s[-]\n",
 		widget.settings.Colors.Subheading,
 		"Username",
 		"Followers",
 		"Tweets",
 	)
 
-	stats := widget.client.GetStats()
+	stats, err := widget.client.GetStats()
+	widget.SetRefreshError(err)
+	if err != nil {
+		return "Twitter Stats", err.Error(), true
+	}
 
 	// Add rows for each of the followed usernames
 	for i, username := range widget.client.screenNames {
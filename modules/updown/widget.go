@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -81,6 +82,7 @@ func (widget *Widget) Refresh() {
 	checks, err := widget.getExistingChecks()
 	widget.checks = checks
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.SetItemCount(len(checks))
 	widget.Render()
 }
@@ -116,20 +118,23 @@ func (widget *Widget) content() (string, string, bool) {
 func (widget *Widget) contentFrom(checks []Check) string {
 	var str string
 
+	roles := widget.settings.Colors.RoleTheme
+
 	for _, check := range checks {
 		prefix := ""
 
 		if !check.Enabled {
-			prefix += "[yellow] ~ "
+			prefix += fmt.Sprintf("[%s] ~ ", roles.Warn)
 		} else if check.Down {
-			prefix += "[red] - "
+			prefix += fmt.Sprintf("[%s] - ", roles.Error)
 		} else {
-			prefix += "[green] + "
+			prefix += fmt.Sprintf("[%s] + ", roles.Ok)
 		}
 
-		str += fmt.Sprintf(`%s%s [gray](%0.2f|%s)[white]%s`,
+		str += fmt.Sprintf(`%s%s [%s](%0.2f|%s)[-]%s`,
 			prefix,
 			check.Alias,
+			roles.Muted,
 			check.Uptime,
 			timeSincePing(check.LastCheckAt),
 			"\n",
@@ -176,7 +181,7 @@ func (widget *Widget) getExistingChecks() ([]Check, error) {
 	}
 	req.Header.Set("User-Agent", userAgent)
 	req.Header.Set("X-API-KEY", widget.settings.apiKey)
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := httpclient.Client().Do(req)
 
 	if err != nil {
 		return nil, err
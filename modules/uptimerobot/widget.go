@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -60,6 +60,7 @@ func (widget *Widget) Refresh() {
 
 	widget.monitors = monitors
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.SetItemCount(len(monitors))
 
 	widget.Render()
@@ -98,23 +99,26 @@ func (widget *Widget) content() (string, string, bool) {
 func (widget *Widget) contentFrom(monitors []Monitor) string {
 	var str string
 
+	roles := widget.settings.Colors.RoleTheme
+
 	for _, monitor := range monitors {
 		prefix := ""
 
 		switch monitor.State {
 		case 2:
-			prefix += "[green] + "
+			prefix += fmt.Sprintf("[%s] + ", roles.Ok)
 		case 8:
 		case 9:
-			prefix += "[red] - "
+			prefix += fmt.Sprintf("[%s] - ", roles.Error)
 		default:
-			prefix += "[yellow] ~ "
+			prefix += fmt.Sprintf("[%s] ~ ", roles.Warn)
 		}
 
-		str += fmt.Sprintf(`%s%s [gray](%s)[white]
+		str += fmt.Sprintf(`%s%s [%s](%s)[-]
 `,
 			prefix,
 			monitor.Name,
+			roles.Muted,
 			formatUptimes(monitor.Uptime),
 		)
 	}
@@ -147,7 +151,7 @@ type Monitor struct {
 
 func (widget *Widget) getMonitors() ([]Monitor, error) {
 	// See: https://uptimerobot.com/api/#getMonitorsWrap
-	resp, errh := http.PostForm("https://api.uptimerobot.com/v2/getMonitors",
+	resp, errh := httpclient.Client().PostForm("https://api.uptimerobot.com/v2/getMonitors",
 		url.Values{
 			"api_key":              {widget.settings.apiKey},
 			"format":               {"json"},
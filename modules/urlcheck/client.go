@@ -3,7 +3,6 @@ package urlcheck
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 	"time"
 
@@ -20,7 +19,7 @@ func DoRequest(urlRequest string, timeout time.Duration, client *http.Client) (i
 	// Request
 	req, err := http.NewRequest(http.MethodHead, urlRequest, nil)
 	if err != nil {
-		logger.Log(fmt.Sprintf("[urlcheck] ERROR %s: %s", urlRequest, err.Error()))
+		logger.Module("urlcheck").Errorf("%s: %s", urlRequest, err.Error())
 		return InvalidResultCode, "New Request Error"
 	}
 	req = req.WithContext(ctx)
@@ -30,10 +29,10 @@ func DoRequest(urlRequest string, timeout time.Duration, client *http.Client) (i
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			status := "Timeout"
-			logger.Log(fmt.Sprintf("[urlcheck] %s: %s", urlRequest, status))
+			logger.Module("urlcheck").Warnf("%s: %s", urlRequest, status)
 			return InvalidResultCode, status
 		}
-		logger.Log(fmt.Sprintf("[urlcheck] %s: %s", urlRequest, err.Error()))
+		logger.Module("urlcheck").Errorf("%s: %s", urlRequest, err.Error())
 		return InvalidResultCode, "Error"
 	}
 
@@ -20,7 +20,7 @@ func (widget *Widget) PrepareTemplate() {
 		labelColor + "{{.ResultMessage}}" +
 		"\n{{end}}"
 
-	widget.PreparedTemplate = template.New("tmpl").Funcs(template.FuncMap{"getResultColor": getResultColor})
+	widget.PreparedTemplate = template.New("tmpl").Funcs(template.FuncMap{"getResultColor": widget.getResultColor})
 }
 
 // Parse the results at each refresh of the widge
@@ -44,15 +44,17 @@ func (widget *Widget) FormatResult() string {
 	return resultBuffer.String()
 }
 
-// URLs with no issues will have their result code in green, otherways in red.
-func getResultColor(ur urlResult) string {
+// URLs with no issues will have their result code in the ok color, otherways in the error color.
+func (widget *Widget) getResultColor(ur urlResult) string {
+	roles := widget.settings.Common.Colors.RoleTheme
+
 	if !ur.IsValid {
-		return "[red]"
+		return fmt.Sprintf("[%s]", roles.Error)
 	}
 
 	if ur.ResultCode < http.StatusInternalServerError {
-		return "[green]"
+		return fmt.Sprintf("[%s]", roles.Ok)
 	}
 
-	return "[red]"
+	return fmt.Sprintf("[%s]", roles.Error)
 }
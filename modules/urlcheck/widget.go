@@ -1,11 +1,14 @@
 package urlcheck
 
 import (
+	"fmt"
 	"net/http"
 	"text/template"
 	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -29,7 +32,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 
 		settings: settings,
 		urlList:  make([]*urlResult, maxUrl),
-		client:   &http.Client{},
+		client:   httpclient.Client(),
 		timeout:  time.Duration(settings.requestTimeout) + time.Second,
 	}
 
@@ -43,7 +46,8 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 
 // Refresh updates the onscreen contents of the widget
 func (widget *Widget) Refresh() {
-	widget.check()
+	widget.SetRefreshError(widget.check())
+	widget.RaiseEvents(widget.events())
 	widget.display()
 }
 
@@ -60,13 +64,55 @@ func (widget *Widget) init() {
 	}
 }
 
-// Do the actual requests and check the responses at every widget refresh
-func (widget *Widget) check() {
+// Do the actual requests and check the responses at every widget refresh. Returns an
+// error for the first URL that is invalid or couldn't be requested at all
+func (widget *Widget) check() error {
+	var err error
+
 	for _, urlRes := range widget.urlList {
 		if urlRes.IsValid {
 			urlRes.ResultCode, urlRes.ResultMessage = DoRequest(urlRes.Url, widget.timeout, widget.client)
 		}
+
+		if urlRes.ResultCode == InvalidResultCode && err == nil {
+			err = fmt.Errorf("%s: %s", urlRes.Url, urlRes.ResultMessage)
+		}
+	}
+
+	return err
+}
+
+// events turns the URLs that didn't respond with 200 OK into events, so that they can
+// be alerted on
+func (widget *Widget) events() []notify.Event {
+	events := []notify.Event{}
+
+	for _, urlRes := range widget.urlList {
+		if !urlRes.IsValid || urlRes.ResultCode == http.StatusOK {
+			continue
+		}
+
+		severity := notify.SeverityWarn
+		if urlRes.ResultCode >= http.StatusInternalServerError {
+			severity = notify.SeverityError
+		}
+
+		message := fmt.Sprintf("Responded with %s", urlRes.ResultMessage)
+		if urlRes.ResultCode == InvalidResultCode {
+			message = fmt.Sprintf("Didn't respond: %s", urlRes.ResultMessage)
+		}
+
+		events = append(events, notify.Event{
+			Key:      urlRes.Url,
+			Kind:     "status",
+			Message:  message,
+			Severity: severity,
+			Title:    urlRes.Url,
+			URL:      urlRes.Url,
+		})
 	}
+
+	return events
 }
 
 // Format and displays the results at every refresh
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/logger"
 )
 
@@ -22,17 +23,17 @@ func Fetch(apiID, apiKey string) ([]OnCallTeam, error) {
 func victorOpsRequest(url string, apiID string, apiKey string) ([]OnCallTeam, error) {
 	req, err := http.NewRequest("GET", url, http.NoBody)
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to initialize sessions to VictorOps. ERROR: %s", err))
+		logger.Module("victorops").Errorf("Failed to initialize sessions to VictorOps: %s", err)
 		return nil, err
 	}
 
 	req.Header.Set("X-VO-Api-Id", apiID)
 	req.Header.Set("X-VO-Api-Key", apiKey)
-	client := &http.Client{}
+	client := httpclient.Client()
 
 	resp, err := client.Do(req)
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to make request to VictorOps. ERROR: %s", err))
+		logger.Module("victorops").Errorf("Failed to make request to VictorOps: %s", err)
 		return nil, err
 	}
 	if resp.StatusCode != 200 {
@@ -42,7 +43,7 @@ func victorOpsRequest(url string, apiID string, apiKey string) ([]OnCallTeam, er
 
 	response := &OnCallResponse{}
 	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
-		logger.Log(fmt.Sprintf("Failed to decode JSON response. ERROR: %s", err))
+		logger.Module("victorops").Errorf("Failed to decode JSON response: %s", err)
 		return nil, err
 	}
 
@@ -38,6 +38,7 @@ func (widget *Widget) Refresh() {
 	teams, err := Fetch(widget.settings.apiID, widget.settings.apiKey)
 
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.teams = teams
 
 	widget.Redraw(widget.content)
@@ -60,12 +61,12 @@ func (widget *Widget) content() (string, string, bool) {
 			continue
 		}
 
-		str = fmt.Sprintf("%s[green]%s\n", str, team.Name)
+		str = fmt.Sprintf("%s[%s]%s\n", str, widget.settings.Colors.RoleTheme.Accent, team.Name)
 		if len(team.OnCall) == 0 {
-			str = fmt.Sprintf("%s[grey]no one\n", str)
+			str = fmt.Sprintf("%s[%s]no one\n", str, widget.settings.Colors.RoleTheme.Muted)
 		}
 		for _, onCall := range team.OnCall {
-			str = fmt.Sprintf("%s[white]%s - %s\n", str, onCall.Policy, onCall.Userlist)
+			str = fmt.Sprintf("%s[-]%s - %s\n", str, onCall.Policy, onCall.Userlist)
 		}
 
 		str = fmt.Sprintf("%s\n", str)
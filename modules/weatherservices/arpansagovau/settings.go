@@ -22,7 +22,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		city:   ymlConfig.UString("locationid"),
 	}
 
-	settings.SetDocumentationPath("weather_services/arpansagovau")
-
 	return &settings
 }
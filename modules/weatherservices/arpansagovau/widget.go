@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -40,40 +41,40 @@ func (widget *Widget) content() (string, string, bool) {
 		return widget.CommonSettings().Title, fmt.Sprintf("Err: %s", widget.lastError.Error()), true
 	}
 
-	return widget.CommonSettings().Title, formatLocationData(widget.location), true
+	return widget.CommonSettings().Title, formatLocationData(widget.location, widget.settings.Colors.RoleTheme), true
 }
 
 func (widget *Widget) Refresh() {
 	widget.Redraw(widget.content)
 }
 
-func formatLocationData(location *location) string {
+func formatLocationData(location *location, roles cfg.RoleTheme) string {
 	var level string
 	var color string
 	var content string
 
 	if location.name == "" {
-		return "[red]No data?"
+		return "[" + roles.Error + "]No data?"
 	}
 
 	if location.status != "ok" {
-		content = "[red]Data unavailable for "
+		content = "[" + roles.Error + "]Data unavailable for "
 		content += location.name
 		return content
 	}
 
 	switch {
 	case location.index < 2.5:
-		color = "[green]"
+		color = "[" + roles.Ok + "]"
 		level = " (LOW)"
 	case location.index >= 2.5 && location.index < 5.5:
-		color = "[yellow]"
+		color = "[" + roles.Warn + "]"
 		level = " (MODERATE)"
 	case location.index >= 5.5 && location.index < 7.5:
 		color = "[orange]"
 		level = " (HIGH)"
 	case location.index >= 7.5 && location.index < 10.5:
-		color = "[red]"
+		color = "[" + roles.Error + "]"
 		level = " (VERY HIGH)"
 	case location.index >= 10.5:
 		color = "[fuchsia]"
@@ -86,7 +87,7 @@ func formatLocationData(location *location) string {
 	content += color
 	content += fmt.Sprintf("%.2f", location.index)
 	content += level
-	content += "[white]\nLocal time: "
+	content += "[-]\nLocal time: "
 	content += location.time
 	content += " "
 	content += location.date
@@ -29,7 +29,5 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		view:     ymlConfig.UString("view", "0"),
 	}
 
-	settings.SetDocumentationPath("weather_services/prettyweather")
-
 	return &settings
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/httpclient"
 	"github.com/wtfutil/wtf/view"
 	"github.com/wtfutil/wtf/wtf"
 )
@@ -28,14 +29,14 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 }
 
 func (widget *Widget) Refresh() {
-	widget.prettyWeather()
+	widget.SetRefreshError(widget.prettyWeather())
 
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
-// this method reads the config and calls wttr.in for pretty weather
-func (widget *Widget) prettyWeather() {
-	client := &http.Client{}
+// this method reads the config and calls wttr.in for pretty weather, returning the error if it fails
+func (widget *Widget) prettyWeather() error {
+	client := httpclient.Client()
 
 	city := widget.settings.city
 	unit := widget.settings.unit
@@ -44,7 +45,7 @@ func (widget *Widget) prettyWeather() {
 	req, err := http.NewRequest("GET", "https://wttr.in/"+city+"?"+view+"?"+unit, http.NoBody)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	req.Header.Set("Accept-Language", widget.settings.language)
@@ -52,7 +53,7 @@ func (widget *Widget) prettyWeather() {
 	response, err := client.Do(req)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 
 	}
 	defer func() { _ = response.Body.Close() }()
@@ -60,8 +61,10 @@ func (widget *Widget) prettyWeather() {
 	contents, err := io.ReadAll(response.Body)
 	if err != nil {
 		widget.result = err.Error()
-		return
+		return err
 	}
 
 	widget.result = strings.TrimSpace(wtf.ASCIItoTviewColors(string(contents)))
+
+	return nil
 }
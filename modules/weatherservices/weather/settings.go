@@ -40,8 +40,6 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		compact:  ymlConfig.UBool("compact", false),
 	}
 
-	settings.SetDocumentationPath("weather_services/weather/")
-
 	settings.colors.current = ymlConfig.UString("colors.current", "green")
 
 	return &settings
@@ -38,6 +38,7 @@ func (widget *Widget) Refresh() {
 	ticketArray, err := widget.newTickets()
 	ticketArray.Count = len(ticketArray.Tickets)
 	widget.err = err
+	widget.SetRefreshError(err)
 	widget.result = ticketArray
 	widget.Render()
 }
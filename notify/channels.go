@@ -0,0 +1,190 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wtfutil/wtf/httpclient"
+	"github.com/wtfutil/wtf/logger"
+)
+
+// deliveryTimeout is how long a desktop notification command or a webhook has to finish
+const deliveryTimeout = 10 * time.Second
+
+var (
+	terminalMutex = &sync.Mutex{}
+
+	// terminal writes escape sequences to the terminal WTF is displayed in, or is nil
+	// when there's no terminal, such as in headless mode
+	terminal func(sequence string)
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// SetTerminal sets the function that writes escape sequences to the terminal, for the
+// bell and the OSC notifications. It's up to the function to write them when nothing
+// else is being drawn
+func SetTerminal(write func(sequence string)) {
+	terminalMutex.Lock()
+	defer terminalMutex.Unlock()
+
+	terminal = write
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// send delivers an event through each of the rule's channels, logging the failures
+func send(rule Rule, event Event) {
+	log := logger.Module(event.Module)
+
+	for _, channel := range rule.Channels {
+		var err error
+
+		switch channel {
+		case ChannelBell:
+			err = writeTerminal("\a")
+		case ChannelDesktop:
+			err = runCommand(rule.Command, event)
+		case ChannelOSC9:
+			err = writeTerminal(fmt.Sprintf("\x1b]9;%s\a", sanitize(event.heading()+": "+event.Message, "")))
+		case ChannelOSC777:
+			err = writeTerminal(fmt.Sprintf("\x1b]777;notify;%s;%s\a", sanitize(event.heading(), ";"), sanitize(event.Message, "")))
+		case ChannelWebhook:
+			err = postWebhook(rule, event)
+		default:
+			err = fmt.Errorf("unknown channel %q", channel)
+		}
+
+		if err != nil {
+			log.Warnf("Alert %q couldn't be delivered by %s: %s", rule.Name, channel, err)
+		}
+	}
+}
+
+// defaultCommand returns the desktop notification command for the operating system
+func defaultCommand() ([]string, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		// Passing the text as arguments keeps it from being read as AppleScript
+		return []string{
+			"osascript",
+			"-e", "on run argv",
+			"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+			"-e", "end run",
+			"{title}", "{message}",
+		}, nil
+	case "windows":
+		return nil, errors.New("there's no default desktop notification command on Windows, set one with command")
+	default:
+		return []string{"notify-send", "--app-name=wtf", "{title}", "{message}"}, nil
+	}
+}
+
+// postWebhook sends the event to the rule's URL as JSON
+func postWebhook(rule Rule, event Event) error {
+	if rule.URL == "" {
+		return errors.New("no url is set")
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"key":      event.Key,
+		"kind":     event.Kind,
+		"message":  event.Message,
+		"module":   event.Module,
+		"rule":     rule.Name,
+		"severity": event.Severity.String(),
+		"time":     event.Time.Format(time.RFC3339),
+		"title":    event.heading(),
+		"type":     event.Type,
+		"url":      event.URL,
+	})
+	if err != nil {
+		return err
+	}
+
+	client := httpclient.Client()
+	client.Timeout = deliveryTimeout
+
+	resp, err := client.Post(rule.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the webhook responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+// runCommand runs the desktop notification command, replacing {title}, {message},
+// {module} and {url} in its arguments with the event's
+func runCommand(command []string, event Event) error {
+	if len(command) == 0 {
+		var err error
+		if command, err = defaultCommand(); err != nil {
+			return err
+		}
+	}
+
+	replacer := strings.NewReplacer(
+		"{title}", event.heading(),
+		"{message}", event.Message,
+		"{module}", event.Module,
+		"{url}", event.URL,
+	)
+
+	args := make([]string, len(command))
+	for idx, arg := range command {
+		args[idx] = replacer.Replace(arg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput()
+	if err != nil && len(output) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return err
+}
+
+// sanitize makes text safe to put in an escape sequence, dropping control characters
+// and the separators that are given
+func sanitize(text, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return ' '
+		}
+		if r < ' ' || r == 0x7f || strings.ContainsRune(separators, r) {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+// writeTerminal writes an escape sequence to the terminal
+func writeTerminal(sequence string) error {
+	terminalMutex.Lock()
+	write := terminal
+	terminalMutex.Unlock()
+
+	if write == nil {
+		return errors.New("there's no terminal to write to")
+	}
+
+	write(sequence)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Package notify tells users when something changes in the data their widgets display.
+//
+// Modules raise events, such as a new incident or a failing build, each time they
+// refresh. The alert rules in `wtf.alerts` decide which of those events are delivered,
+// and how: with the terminal bell, as an OSC 9 or OSC 777 terminal notification, with
+// a desktop notification command or to a webhook.
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Severity is how serious an event is
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarn
+	SeverityError
+)
+
+var severityNames = map[Severity]string{
+	SeverityInfo:  "info",
+	SeverityWarn:  "warn",
+	SeverityError: "error",
+}
+
+// ParseSeverity returns the severity with the given name. Names are case-insensitive and
+// "warning" is accepted as an alias for "warn"
+func ParseSeverity(name string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "info":
+		return SeverityInfo, nil
+	case "warn", "warning":
+		return SeverityWarn, nil
+	case "error":
+		return SeverityError, nil
+	}
+
+	return SeverityInfo, fmt.Errorf("unknown severity %q, expected info, warn or error", name)
+}
+
+func (severity Severity) String() string {
+	return severityNames[severity]
+}
+
+// Event is something a module noticed that users may want to be told about
+type Event struct {
+	// Key identifies what the event is about, such as an incident ID or a URL. Events
+	// with the same key from the same module are the same event
+	Key string
+
+	// Kind names what happened, such as "incident" or "build-failed", for rules to match on
+	Kind string
+
+	Message  string
+	Severity Severity
+	Title    string
+	URL      string
+
+	// Filled in when the event is raised
+	Module string
+	Time   time.Time
+	Type   string
+}
+
+// heading returns the event's title, or the name of the module that raised it if it
+// has none
+func (event Event) heading() string {
+	if event.Title != "" {
+		return event.Title
+	}
+
+	return event.Module
+}
@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// delivery is an event a rule has decided to deliver
+type delivery struct {
+	event Event
+	rule  Rule
+}
+
+var (
+	notifyMutex = &sync.Mutex{}
+	rules       = []Rule{}
+
+	// The events each module raised the last time it refreshed, keyed on the module's
+	// name and the event's key, and when each rule last delivered them
+	active = map[string]map[string]map[string]time.Time{}
+
+	// deliver sends the alerts, it's replaced in tests
+	deliver = send
+
+	// now is the current time, it's replaced in tests
+	now = time.Now
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// Configure replaces the alert rules. The events modules are raising are kept, so
+// those that have already been delivered aren't delivered again. Rules without a name
+// are named after their position
+func Configure(newRules []Rule) {
+	notifyMutex.Lock()
+	defer notifyMutex.Unlock()
+
+	rules = append([]Rule{}, newRules...)
+	for idx := range rules {
+		if rules[idx].Name == "" {
+			rules[idx].Name = fmt.Sprintf("alert %d", idx+1)
+		}
+	}
+}
+
+// Raise reports the events a module currently has, every time it refreshes. An event
+// is delivered when it first shows up, and again once its rule's repeat interval has
+// passed. One that the module stops raising is over, so it's delivered again if it
+// comes back. The events raised the first time a module refreshes are what was already
+// going on when WTF started, so they're only delivered when they repeat
+func Raise(module, moduleType string, events []Event) {
+	notifyMutex.Lock()
+
+	at := now()
+	previous, known := active[module]
+	current := map[string]map[string]time.Time{}
+	deliveries := []delivery{}
+
+	for _, event := range events {
+		event.Module = module
+		event.Type = moduleType
+		if event.Time.IsZero() {
+			event.Time = at
+		}
+
+		delivered, ok := previous[event.Key]
+		if !ok {
+			delivered = map[string]time.Time{}
+		}
+		current[event.Key] = delivered
+
+		for _, rule := range rules {
+			if len(rule.Channels) == 0 || !rule.Matches(event) {
+				continue
+			}
+
+			last, sent := delivered[rule.Name]
+			if sent && (rule.Repeat <= 0 || at.Sub(last) < rule.Repeat) {
+				continue
+			}
+
+			delivered[rule.Name] = at
+
+			if known {
+				deliveries = append(deliveries, delivery{event: event, rule: rule})
+			}
+		}
+	}
+
+	active[module] = current
+
+	notifyMutex.Unlock()
+
+	for _, d := range deliveries {
+		go deliver(d.rule, d.event)
+	}
+}
@@ -0,0 +1,165 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordDeliveries replaces the delivery of alerts and the clock for the length of a
+// test, and resets the events the modules have raised
+func recordDeliveries(t *testing.T, at *time.Time) chan delivery {
+	delivered := make(chan delivery, 10)
+
+	deliver = func(rule Rule, event Event) { delivered <- delivery{event: event, rule: rule} }
+	now = func() time.Time { return *at }
+	active = map[string]map[string]map[string]time.Time{}
+
+	t.Cleanup(func() {
+		deliver = send
+		now = time.Now
+		Configure(nil)
+	})
+
+	return delivered
+}
+
+// received returns the keys of the events delivered so far
+func received(delivered chan delivery) []string {
+	keys := []string{}
+
+	for {
+		select {
+		case d := <-delivered:
+			keys = append(keys, d.event.Key)
+		case <-time.After(50 * time.Millisecond):
+			return keys
+		}
+	}
+}
+
+func Test_Raise(t *testing.T) {
+	at := time.Date(2022, 3, 1, 9, 0, 0, 0, time.UTC)
+	delivered := recordDeliveries(t, &at)
+
+	Configure([]Rule{
+		{Kinds: []string{"build-failed"}, Channels: []string{ChannelBell}},
+		{Name: "reminders", Modules: []string{"pagerduty"}, Channels: []string{ChannelBell}, Repeat: time.Hour},
+	})
+
+	failed := func(keys ...string) []Event {
+		events := []Event{}
+		for _, key := range keys {
+			events = append(events, Event{Key: key, Kind: "build-failed"})
+		}
+		return events
+	}
+
+	t.Run("the first refresh", func(t *testing.T) {
+		Raise("jenkins", "jenkins", failed("api"))
+		assert.Equal(t, []string{}, received(delivered))
+	})
+
+	t.Run("a new event", func(t *testing.T) {
+		Raise("jenkins", "jenkins", failed("api", "web"))
+		assert.Equal(t, []string{"web"}, received(delivered))
+	})
+
+	t.Run("the same events again", func(t *testing.T) {
+		Raise("jenkins", "jenkins", failed("api", "web"))
+		assert.Equal(t, []string{}, received(delivered))
+	})
+
+	t.Run("an event that comes back", func(t *testing.T) {
+		Raise("jenkins", "jenkins", failed("api"))
+		Raise("jenkins", "jenkins", failed("api", "web"))
+		assert.Equal(t, []string{"web"}, received(delivered))
+	})
+
+	t.Run("an event that repeats", func(t *testing.T) {
+		incident := []Event{{Key: "P123", Kind: "incident", Severity: SeverityError}}
+
+		Raise("oncall", "pagerduty", incident)
+		Raise("oncall", "pagerduty", incident)
+		assert.Equal(t, []string{}, received(delivered))
+
+		at = at.Add(time.Hour)
+		Raise("oncall", "pagerduty", incident)
+		assert.Equal(t, []string{"P123"}, received(delivered))
+	})
+}
+
+func Test_Rule_Matches(t *testing.T) {
+	event := Event{
+		Kind:     "status",
+		Message:  "https://example.com returned 503",
+		Module:   "sites",
+		Severity: SeverityWarn,
+		Type:     "urlcheck",
+	}
+
+	tests := []struct {
+		name     string
+		rule     Rule
+		expected bool
+	}{
+		{name: "with no filters", rule: Rule{}, expected: true},
+		{name: "with the module's name", rule: Rule{Modules: []string{"sites"}}, expected: true},
+		{name: "with the module's type", rule: Rule{Modules: []string{"urlcheck"}}, expected: true},
+		{name: "with another module", rule: Rule{Modules: []string{"jenkins"}}, expected: false},
+		{name: "with another kind", rule: Rule{Kinds: []string{"incident"}}, expected: false},
+		{name: "with a higher severity", rule: Rule{Severity: SeverityError}, expected: false},
+		{name: "with a matching pattern", rule: Rule{Match: regexp.MustCompile(`5\d\d`)}, expected: true},
+		{name: "with a pattern that doesn't match", rule: Rule{Match: regexp.MustCompile(`404`)}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rule.Matches(event))
+		})
+	}
+}
+
+func Test_send(t *testing.T) {
+	sequences := []string{}
+	SetTerminal(func(sequence string) { sequences = append(sequences, sequence) })
+	defer SetTerminal(nil)
+
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	rule := Rule{
+		Name:     "builds",
+		Channels: []string{ChannelBell, ChannelOSC9, ChannelOSC777, ChannelWebhook},
+		URL:      server.URL,
+	}
+
+	send(rule, Event{Key: "api", Module: "ci", Title: "api; main", Message: "Build\nfailed\x1b"})
+
+	assert.Equal(t, []string{
+		"\a",
+		"\x1b]9;api; main: Build failed\a",
+		"\x1b]777;notify;api main;Build failed\a",
+	}, sequences)
+
+	assert.Equal(t, "builds", payload["rule"])
+	assert.Equal(t, "api; main", payload["title"])
+	assert.Equal(t, "info", payload["severity"])
+}
+
+func Test_ParseSeverity(t *testing.T) {
+	severity, err := ParseSeverity("Warning")
+	assert.NoError(t, err)
+	assert.Equal(t, SeverityWarn, severity)
+
+	_, err = ParseSeverity("critical")
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"regexp"
+	"time"
+)
+
+// The ways an alert can be delivered
+const (
+	ChannelBell    = "bell"
+	ChannelDesktop = "desktop"
+	ChannelOSC9    = "osc9"
+	ChannelOSC777  = "osc777"
+	ChannelWebhook = "webhook"
+)
+
+// Channels returns the names of the ways an alert can be delivered
+func Channels() []string {
+	return []string{ChannelBell, ChannelDesktop, ChannelOSC9, ChannelOSC777, ChannelWebhook}
+}
+
+// Rule picks the events to deliver and how they're delivered. Empty filters match every
+// event
+type Rule struct {
+	// Name identifies the rule in the log and to webhooks, and records which events
+	// it's delivered
+	Name string
+
+	// Filters
+	Kinds    []string
+	Match    *regexp.Regexp
+	Modules  []string
+	Severity Severity
+
+	// Delivery
+	Channels []string
+	Command  []string
+	Repeat   time.Duration
+	URL      string
+}
+
+// Matches returns TRUE if the event passes all of the rule's filters. Modules are
+// matched on either their name or their type. The pattern is matched against the
+// event's title and message
+func (rule *Rule) Matches(event Event) bool {
+	if event.Severity < rule.Severity {
+		return false
+	}
+
+	if len(rule.Modules) > 0 && !contains(rule.Modules, event.Module) && !contains(rule.Modules, event.Type) {
+		return false
+	}
+
+	if len(rule.Kinds) > 0 && !contains(rule.Kinds, event.Kind) {
+		return false
+	}
+
+	if rule.Match != nil && !rule.Match.MatchString(event.Title+"\n"+event.Message) {
+		return false
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+
+	return false
+}
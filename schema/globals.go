@@ -0,0 +1,198 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/notify"
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// GlobalKeys returns the keys that can be set in the `wtf` section of the config file,
+// in alphabetical order
+func GlobalKeys() []string {
+	keys := []string{}
+	for key := range globalProperties(nil) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func globalsSchema(moduleTypes []string) object {
+	return object{
+		"type":        "object",
+		"description": "The global settings and the modules to display",
+		"properties":  globalProperties(moduleTypes),
+	}
+}
+
+func globalProperties(moduleTypes []string) object {
+	channel := enumSchema("", notify.Channels())
+
+	return object{
+		"alerts": object{
+			"description": "Rules that pick the events modules raise, such as failed builds or new incidents, and deliver them as notifications",
+			"type":        "array",
+			"items": objectSchema("", object{
+				"command": includeSchema("For the desktop channel, the command to run instead of notify-send or osascript. {title}, {message}, {module} and {url} are replaced in its arguments"),
+				"kinds":   includeSchema("The kinds of event to deliver, such as build-failed, due, incident or status"),
+				"match":   stringSchema("A regular expression the event's title or message must match"),
+				"modules": includeSchema("The names or types of the modules whose events are delivered"),
+				"name":    stringSchema("The name of the rule, used in the log and sent to webhooks"),
+				"notify": object{
+					"description": "How the events are delivered",
+					"oneOf":       []object{channel, {"type": "array", "items": channel}},
+				},
+				"repeat":   durationSchema("How often an event that's still going on is delivered again. Defaults to only when it starts"),
+				"severity": enumSchema("The lowest severity of the events to deliver", []string{"info", "warn", "error"}),
+				"url":      stringSchema("For the webhook channel, the URL the events are posted to as JSON"),
+			}),
+		},
+		"colors": colorsSchema("The default colors for every module"),
+		"dashboards": object{
+			"description":          "Other config files to load as dashboards, switched between with Ctrl+Space. Either a map of dashboard names to config file paths, or a list of paths",
+			"type":                 []string{"object", "array"},
+			"items":                stringSchema(""),
+			"additionalProperties": stringSchema(""),
+		},
+		"exitMessage": objectSchema("The message displayed when the app quits", object{
+			"display":      booleanSchema("Whether or not to display the exit message", true),
+			"githubAPIKey": stringSchema("A GitHub API key, used to check whether you're a contributor or a sponsor"),
+		}),
+		"grid": objectSchema("The layout of the grid the modules are displayed in", object{
+			"columns": integerListSchema("The width of each column, in characters"),
+			"rows":    integerListSchema("The height of each row, in lines"),
+		}),
+		"headless": objectSchema("Settings for headless mode", object{
+			"address": stringSchema("The address to serve widget data on"),
+		}),
+		"http": objectSchema("How modules make HTTP requests", object{
+			"caFile":     stringSchema("A PEM file of certificate authorities to trust besides the system's"),
+			"clientCert": stringSchema("A PEM certificate presented to servers that ask for a client certificate"),
+			"clientKey":  stringSchema("The PEM key of the client certificate"),
+			"hosts": object{
+				"description": "Rate limits and retries for particular hosts. The first one that matches a request's host applies",
+				"type":        "array",
+				"items": objectSchema("", object{
+					"burst":     integerSchema("How many requests can be made to the host at once. Defaults to 1"),
+					"host":      stringSchema("The host name, which can contain * wildcards, such as *.atlassian.net"),
+					"rateLimit": numberSchema("The most requests a second made to the host"),
+					"retries":   integerSchema("How many times requests that fail with a network error or a 429, 502, 503 or 504 response are tried again"),
+					"retryWait": durationSchema("How long to wait before the first retry, doubled after each one. Defaults to 1s"),
+				}),
+			},
+			"proxy":     stringSchema("The URL of the proxy requests go through. Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables"),
+			"timeout":   durationSchema("How long a request can take. Defaults to 30s"),
+			"userAgent": stringSchema("The User-Agent header sent by modules that don't set their own"),
+		}),
+		"keys": keyBindingsSchema("Rebinds keyboard commands, mapping the name of each action to a key or a list of keys. Applies to the app-wide actions and to every widget"),
+		"layouts": object{
+			"description": "Named layouts used instead of the grid while the terminal's size is within their breakpoints. The first one that matches is used",
+			"type":        "array",
+			"items": objectSchema("", object{
+				"columnWidth": integerSchema("For flow layouts, how wide each column should be. Defaults to 50"),
+				"columns":     integerListSchema("For grid layouts, the width of each column, in characters"),
+				"flow":        booleanSchema("Whether the widgets are packed into as many columns as fit, in order of priority, instead of placed at their positions", false),
+				"maxColumns":  integerSchema("For flow layouts, the most columns to use"),
+				"maxHeight":   integerSchema("The tallest the terminal can be for this layout to be used"),
+				"maxWidth":    integerSchema("The widest the terminal can be for this layout to be used"),
+				"minHeight":   integerSchema("The shortest the terminal can be for this layout to be used"),
+				"minWidth":    integerSchema("The narrowest the terminal can be for this layout to be used"),
+				"name":        stringSchema("The name modules use to give their position in this layout"),
+				"rowHeight":   integerSchema("For flow layouts, the height of each row. Defaults to sharing the screen equally"),
+				"rows":        integerListSchema("For grid layouts, the height of each row, in lines"),
+			}),
+		},
+		"language": stringSchema("The BCP 47 language tag to localize text to"),
+		"log": objectSchema("Where and what to log", object{
+			"level":      enumSchema("The minimum level of the entries written to the log", []string{"debug", "info", "warn", "error"}),
+			"maxBackups": integerSchema("The number of rotated log files to keep"),
+			"maxSize":    integerSchema("The size in megabytes the log can reach before it's rotated"),
+			"path":       stringSchema("The path to the log file. Defaults to log.txt in the config directory"),
+		}),
+		"mods":  modsSchema(moduleTypes),
+		"mouse": booleanSchema("Whether clicking focuses widgets and selects rows, and the wheel scrolls them. Off by default so that text can be selected with the mouse", false),
+		"navigation": objectSchema("Keyboard navigation settings", object{
+			"shortcuts": booleanSchema("Whether or not to display the focus shortcut keys in widget titles", true),
+		}),
+		"openFileUtil": stringSchema("The command used to open files"),
+		"openUrlUtil": object{
+			"description": "The command, and its arguments, used to open URLs",
+			"type":        "array",
+			"items":       stringSchema(""),
+		},
+		"paging": objectSchema("Deprecated, use sigils.paging instead", object{
+			"pageSigil":     stringSchema("The character displayed for each page"),
+			"selectedSigil": stringSchema("The character displayed for the current page"),
+		}),
+		"restoreState": booleanSchema("Whether the selected items, the displayed sources and dashboard and the focused widget are remembered in state.json in the config directory, and restored when WTF starts", true),
+		"scheduler": objectSchema("How widget refreshes are scheduled", object{
+			"jitter":     numberSchema("The fraction of each refresh interval to randomly add or subtract, from 0 to 1"),
+			"maxBackoff": durationSchema("The longest a failing module will wait between refreshes"),
+			"stagger":    durationSchema("The delay between the first refreshes of successive modules"),
+		}),
+		"secretStore": stringSchema("The credential helper used to store secrets, such as osxkeychain, secretservice or winrt"),
+		"secrets": objectSchema("Where module secrets are looked up, besides the credential helper set by secretStore", object{
+			"env": objectSchema("Secrets read from environment variables", object{
+				"prefix": stringSchema("The prefix of the variables, followed by the uppercased service name. Defaults to WTF_SECRET_"),
+			}),
+			"file": objectSchema("Secrets read from a YAML file mapping services to secrets", object{
+				"decrypt":  enumSchema("How the file is decrypted. Defaults to age for .age files, gpg for .gpg and .asc files, and none otherwise", []string{"age", "gpg", "none"}),
+				"identity": stringSchema("The age identity file used to decrypt the file"),
+				"path":     stringSchema("The path to the secrets file"),
+			}),
+			"pass": objectSchema("Secrets read from the pass password store", object{
+				"prefix": stringSchema("The folder of the entries, followed by the service name. Defaults to wtf/"),
+			}),
+			"providers": object{
+				"description": "The secret providers to use, in the order they're asked. Defaults to env, file, pass and store",
+				"type":        "array",
+				"items":       enumSchema("", []string{"env", "file", "pass", "store"}),
+			},
+		}),
+		"sigils": objectSchema("The characters used to draw checkboxes and paging", object{
+			"checkbox": objectSchema("", object{
+				"checked":   stringSchema(""),
+				"unchecked": stringSchema(""),
+			}),
+			"paging": objectSchema("", object{
+				"normal": stringSchema(""),
+				"select": stringSchema(""),
+			}),
+		}),
+		"term": stringSchema("The terminal type to use, overriding the TERM environment variable"),
+		"theme": stringSchema("The colors to use, either the path of a theme file or the name of a theme: " +
+			strings.Join(cfg.BuiltinThemes(), ", ") + ", or one in the themes directory of the config directory"),
+	}
+}
+
+func modsSchema(moduleTypes []string) object {
+	properties := object{}
+
+	// A module's type defaults to its name, so a module named after a type without
+	// a `type` key is that type of module
+	for _, moduleType := range moduleTypes {
+		properties[moduleType] = object{
+			"allOf": []object{
+				{"$ref": moduleRef},
+				{
+					"if":   object{"not": object{"required": []string{"type"}}},
+					"then": object{"$ref": modulesRef + moduleType},
+				},
+			},
+		}
+	}
+
+	return object{
+		"description":          "The modules to display, keyed on a unique name",
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": object{"$ref": moduleRef},
+	}
+}
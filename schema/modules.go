@@ -0,0 +1,229 @@
+package schema
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// CommonModuleKeys returns the keys that every module understands, in alphabetical order
+func CommonModuleKeys() []string {
+	return []string{
+		"border",
+		"cache",
+		"colors",
+		"enabled",
+		"focusChar",
+		"focusable",
+		"keys",
+		"layouts",
+		"position",
+		"priority",
+		"refreshInterval",
+		"title",
+		"type",
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// commonModuleSchema describes the settings every module has, and picks the schema for
+// the module-specific settings based on the `type` key
+func commonModuleSchema(moduleTypes []string) object {
+	typeSchemas := []object{}
+	for _, moduleType := range moduleTypes {
+		typeSchemas = append(typeSchemas, object{
+			"if": object{
+				"properties": object{"type": object{"const": moduleType}},
+				"required":   []string{"type"},
+			},
+			"then": object{"$ref": modulesRef + moduleType},
+		})
+	}
+
+	return object{
+		"type": "object",
+		"properties": object{
+			"border":    booleanSchema(commonHelp("Bordered"), true),
+			"cache":     booleanSchema(commonHelp("Cache"), true),
+			"colors":    colorsSchema("The colors for this module, overriding the global colors"),
+			"enabled":   booleanSchema(commonHelp("Enabled"), false),
+			"focusChar": integerSchema(commonHelp("focusChar")),
+			"focusable": booleanSchema(commonHelp("Focusable"), nil),
+			"keys":      keyBindingsSchema(commonHelp("Keys")),
+			"layouts": object{
+				"description":          commonHelp("Layouts"),
+				"type":                 "object",
+				"additionalProperties": positionSchema(),
+			},
+			"position":        positionSchema(),
+			"priority":        integerSchema(commonHelp("Priority")),
+			"refreshInterval": durationSchema(commonHelp("RefreshInterval")),
+			"title":           stringSchema(commonHelp("Title")),
+			"type":            enumSchema("The type of module. Defaults to the module's name", moduleTypes),
+		},
+		"allOf": typeSchemas,
+	}
+}
+
+// commonHelp returns the help text for one of the fields of cfg.Common
+func commonHelp(fieldName string) string {
+	field, ok := reflect.TypeOf(cfg.Common{}).FieldByName(fieldName)
+	if !ok {
+		return ""
+	}
+
+	return field.Tag.Get("help")
+}
+
+// fieldSchema describes a settings struct field using its struct tags
+func fieldSchema(field reflect.StructField) object {
+	schema := object{}
+
+	description := strings.TrimSpace(field.Tag.Get("help"))
+	if values := field.Tag.Get("values"); values != "" {
+		if !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+		description += " Values: " + values
+	}
+	if description != "" {
+		schema["description"] = description
+	}
+
+	fieldType := field.Type
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	// Durations are read with cfg.ParseTimeString, as seconds or as duration strings
+	if fieldType == reflect.TypeOf(time.Duration(0)) {
+		return durationSchema(description)
+	}
+
+	if jsonType := jsonType(fieldType); jsonType != nil {
+		schema["type"] = jsonType
+	}
+
+	if fieldType.Kind() == reflect.Struct {
+		schema["properties"] = structProperties(fieldType)
+	}
+
+	if defaultValue, ok := parseDefault(fieldType, field.Tag.Get("default")); ok {
+		schema["default"] = defaultValue
+	}
+
+	return schema
+}
+
+// jsonType returns the JSON Schema type for a Go type, or nil if it can't be described
+func jsonType(fieldType reflect.Type) interface{} {
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		// Some modules build their lists from maps, so both are acceptable
+		return []string{"array", "object"}
+	case reflect.Map, reflect.Struct:
+		return "object"
+	}
+
+	return nil
+}
+
+// moduleSchema describes the module-specific settings of a type of module
+func moduleSchema(moduleType string) object {
+	schema := object{"type": "object"}
+
+	settings := moduleSettings(moduleType)
+	if !settings.IsValid() {
+		return schema
+	}
+
+	properties := structProperties(settings.Type())
+	if len(properties) > 0 {
+		schema["properties"] = properties
+	}
+
+	return schema
+}
+
+// moduleSettings creates the settings for a module of the given type with nothing
+// configured, to find out what settings that type of module has
+func moduleSettings(moduleType string) reflect.Value {
+	moduleConfig, err := config.ParseYaml("wtf:\n  mods:\n    " + strconv.Quote(moduleType) + ":\n      type: " + strconv.Quote(moduleType) + "\n")
+	if err != nil {
+		return reflect.Value{}
+	}
+
+	value := reflect.ValueOf(app.MakeSettings(moduleType, moduleConfig))
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	return value
+}
+
+// parseDefault converts a `default` struct tag into a value of the field's type
+func parseDefault(fieldType reflect.Type, tag string) (interface{}, bool) {
+	if tag == "" {
+		return nil, false
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		if val, err := strconv.ParseBool(tag); err == nil {
+			return val, true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val, err := strconv.Atoi(tag); err == nil {
+			return val, true
+		}
+	case reflect.Float32, reflect.Float64:
+		if val, err := strconv.ParseFloat(tag, 64); err == nil {
+			return val, true
+		}
+	case reflect.String:
+		return tag, true
+	}
+
+	return nil, false
+}
+
+// structProperties describes the fields of a settings struct, skipping the settings
+// common to all modules
+func structProperties(structType reflect.Type) object {
+	properties := object{}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.Anonymous && field.Type == reflect.TypeOf(&cfg.Common{}) {
+			continue
+		}
+
+		properties[utils.LowercaseTitle(field.Name)] = fieldSchema(field)
+	}
+
+	return properties
+}
@@ -0,0 +1,73 @@
+// Package schema generates a JSON Schema describing the config file, for editors that
+// support autocompletion and validation of YAML files against a schema, such as
+// yaml-language-server.
+//
+// Module settings are described by the same `help`, `values`, `optional` and `default`
+// struct tags that `wtfutil --module` reads to display a module's configuration help.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	draft = "http://json-schema.org/draft-07/schema#"
+
+	moduleRef  = "#/definitions/module"
+	modulesRef = "#/definitions/modules/"
+)
+
+// object is a JSON Schema, or a part of one
+type object map[string]interface{}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Display writes the JSON Schema to the console
+func Display() {
+	data, err := json.MarshalIndent(Generate(), "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(data))
+}
+
+// Generate returns the JSON Schema for the config file
+func Generate() map[string]interface{} {
+	moduleTypes := wtf.ModuleTypes()
+
+	modules := object{}
+	for _, moduleType := range moduleTypes {
+		modules[moduleType] = moduleSchema(moduleType)
+	}
+
+	return object{
+		"$schema":     draft,
+		"title":       "WTF configuration",
+		"description": "The configuration file for wtfutil, https://wtfutil.com",
+		"type":        "object",
+		"properties": object{
+			"environments": object{
+				"description": "Overlays merged over the config when their name is selected with --env or WTF_ENV. Each is either the settings to merge or the files to include",
+				"type":        "object",
+				"additionalProperties": object{
+					"oneOf": []object{
+						{"$ref": "#"},
+						includeSchema(""),
+					},
+				},
+			},
+			"include": includeSchema("Other config files merged under this one, in order, so that its own settings win. Paths are relative to this file and can be globs, such as mods.d/*.yml"),
+			"wtf":     globalsSchema(moduleTypes),
+		},
+		"definitions": object{
+			"module":  commonModuleSchema(moduleTypes),
+			"modules": modules,
+		},
+	}
+}
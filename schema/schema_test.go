@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "github.com/wtfutil/wtf/modules/all"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+func Test_Generate(t *testing.T) {
+	generated := Generate()
+
+	_, err := json.Marshal(generated)
+	assert.NoError(t, err)
+
+	definitions := generated["definitions"].(object)
+	modules := definitions["modules"].(object)
+
+	assert.Equal(t, len(wtf.ModuleTypes()), len(modules))
+
+	clocks := modules["clocks"].(object)
+	properties := clocks["properties"].(object)
+
+	assert.Contains(t, properties, "locations")
+	assert.Contains(t, properties["sort"].(object)["description"], "display order")
+}
+
+func Test_GlobalKeys(t *testing.T) {
+	keys := GlobalKeys()
+
+	assert.Contains(t, keys, "mods")
+	assert.Contains(t, keys, "colors")
+	assert.NotContains(t, keys, "refreshInterval")
+}
+
+func Test_fieldSchema(t *testing.T) {
+	type settings struct {
+		limit   int      `help:"How many to show" values:"A positive number" default:"10"`
+		enabled bool     `help:"Whether to show it." default:"yes"`
+		names   []string `help:"Who to show."`
+	}
+
+	settingsType := reflect.TypeOf(settings{})
+
+	tests := []struct {
+		name     string
+		field    string
+		expected object
+	}{
+		{
+			name:  "with default and values",
+			field: "limit",
+			expected: object{
+				"description": "How many to show. Values: A positive number",
+				"type":        "integer",
+				"default":     10,
+			},
+		},
+		{
+			name:  "unparseable default",
+			field: "enabled",
+			expected: object{
+				"description": "Whether to show it.",
+				"type":        "boolean",
+			},
+		},
+		{
+			name:  "list",
+			field: "names",
+			expected: object{
+				"description": "Who to show.",
+				"type":        []string{"array", "object"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, _ := settingsType.FieldByName(tt.field)
+			assert.Equal(t, tt.expected, fieldSchema(field))
+		})
+	}
+}
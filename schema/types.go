@@ -0,0 +1,141 @@
+package schema
+
+import "github.com/wtfutil/wtf/cfg"
+
+// durationPattern matches the values cfg.ParseTimeString understands: a whole number of
+// seconds, or a Go duration such as "1h30m"
+const durationPattern = `^([0-9]+|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`
+
+/* -------------------- Unexported Functions -------------------- */
+
+func booleanSchema(description string, defaultValue interface{}) object {
+	schema := withDescription(object{"type": "boolean"}, description)
+	if defaultValue != nil {
+		schema["default"] = defaultValue
+	}
+
+	return schema
+}
+
+func colorsSchema(description string) object {
+	theme := cfg.NewDefaultColorTheme()
+
+	return objectSchema(description, object{
+		"background": colorSchema("The widget background color", theme.WidgetTheme.Background),
+		"border": objectSchema("Border colors", object{
+			"error":     colorSchema("The border color of a widget whose last refresh failed", theme.BorderTheme.Error),
+			"focusable": colorSchema("The border color of a widget that can be focused", theme.BorderTheme.Focusable),
+			"focused":   colorSchema("The border color of the focused widget", theme.BorderTheme.Focused),
+			"normal":    colorSchema("The border color of a widget that can't be focused", theme.BorderTheme.Unfocusable),
+		}),
+		"checked": colorSchema("The color of checked items", theme.CheckboxTheme.Checked),
+		"label":   colorSchema("The color of labels", theme.TextTheme.Label),
+		"roles": objectSchema("The colors modules use to show what their content means", object{
+			"accent":    colorSchema("The color of names and other things worth picking out", theme.RoleTheme.Accent),
+			"error":     colorSchema("The color of failures and things that are down", theme.RoleTheme.Error),
+			"highlight": colorSchema("The color of selected or newly changed content", theme.RoleTheme.Highlight),
+			"muted":     colorSchema("The color of secondary details, such as timestamps", theme.RoleTheme.Muted),
+			"ok":        colorSchema("The color of successes and things that are up", theme.RoleTheme.Ok),
+			"warn":      colorSchema("The color of warnings and things that need attention", theme.RoleTheme.Warn),
+		}),
+		"rows": objectSchema("Row colors", object{
+			"even": colorSchema("The color of even rows", theme.RowTheme.EvenForeground),
+			"odd":  colorSchema("The color of odd rows", theme.RowTheme.OddForeground),
+		}),
+		"subheading": colorSchema("The color of subheadings", theme.TextTheme.Subheading),
+		"text":       colorSchema("The color of text", theme.TextTheme.Text),
+		"title":      colorSchema("The color of the widget title", theme.TextTheme.Title),
+	})
+}
+
+func colorSchema(description, defaultValue string) object {
+	schema := stringSchema(description)
+	schema["default"] = defaultValue
+
+	return schema
+}
+
+func durationSchema(description string) object {
+	return withDescription(object{
+		"oneOf": []object{
+			{"type": "integer", "minimum": 0},
+			{"type": "string", "pattern": durationPattern},
+		},
+	}, description)
+}
+
+func enumSchema(description string, values []string) object {
+	return withDescription(object{"type": "string", "enum": values}, description)
+}
+
+func includeSchema(description string) object {
+	return withDescription(object{
+		"type":  []string{"string", "array"},
+		"items": stringSchema(""),
+	}, description)
+}
+
+func integerListSchema(description string) object {
+	return withDescription(object{
+		"type":  "array",
+		"items": object{"type": "integer", "minimum": 0},
+	}, description)
+}
+
+func integerSchema(description string) object {
+	return withDescription(object{"type": "integer"}, description)
+}
+
+func keyBindingsSchema(description string) object {
+	key := stringSchema("A single character, or the name of a special key such as \"Enter\" or \"Ctrl-D\"")
+
+	return withDescription(object{
+		"type": "object",
+		"additionalProperties": object{
+			"oneOf": []object{
+				key,
+				{"type": "array", "items": key},
+				{"type": "null"},
+			},
+		},
+	}, description)
+}
+
+func numberSchema(description string) object {
+	return withDescription(object{"type": "number"}, description)
+}
+
+func objectSchema(description string, properties object) object {
+	return withDescription(object{"type": "object", "properties": properties}, description)
+}
+
+func positionSchema() object {
+	cell := func(description string, minimum int) object {
+		return object{"type": "integer", "minimum": minimum, "description": description}
+	}
+
+	return object{
+		"description": "Where in the grid this module's widget is displayed. Without one, the widget is only displayed by flow layouts",
+		"type":        "object",
+		"properties": object{
+			"height": cell("The number of rows the widget spans", 1),
+			"left":   cell("The column the widget starts in, starting from 0", 0),
+			"top":    cell("The row the widget starts in, starting from 0", 0),
+			"width":  cell("The number of columns the widget spans", 1),
+		},
+		"required":             []string{"height", "left", "top", "width"},
+		"additionalProperties": false,
+	}
+}
+
+func stringSchema(description string) object {
+	return withDescription(object{"type": "string"}, description)
+}
+
+func withDescription(schema object, description string) object {
+	if description != "" {
+		schema["description"] = description
+	}
+
+	return schema
+}
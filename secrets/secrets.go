@@ -0,0 +1,142 @@
+// Package secrets implements the commands that manage the secrets modules load with
+// cfg.ModuleSecret: list-secrets, delete-secret and check-secrets
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// Check is a secret a module in the config looks up, and whether it was found
+type Check struct {
+	cfg.SecretLookup
+
+	// Enabled is whether the module that looks up the secret is enabled
+	Enabled bool
+}
+
+// Found returns TRUE if the secret was set in the config or found by a secret provider
+func (check Check) Found() bool {
+	return check.Source != ""
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// CheckSecrets creates the settings of every module in the config, enabled or not, and
+// returns the secrets they looked up, ordered by module name. Creating the settings is
+// what works out the service each module uses, whether that's its name or its baseURL
+func CheckSecrets(config *config.Config) []Check {
+	moduleNames := []string{}
+	for moduleName := range config.UMap("wtf.mods") {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	checks := []Check{}
+
+	for _, moduleName := range moduleNames {
+		lookups := cfg.RecordSecretLookups(func() {
+			app.MakeSettings(moduleName, config)
+		})
+
+		enabled := config.UBool("wtf.mods."+moduleName+".enabled", false)
+		for _, lookup := range lookups {
+			checks = append(checks, Check{SecretLookup: lookup, Enabled: enabled})
+		}
+	}
+
+	return checks
+}
+
+// DisplayCheck prints the secret each module looks up and where it was found, with how
+// to save the ones that are missing. It returns the exit code: 1 if an enabled module is
+// missing a secret, 0 otherwise
+func DisplayCheck(config *config.Config) int {
+	checks := CheckSecrets(config)
+	if len(checks) == 0 {
+		fmt.Println("No modules use secrets")
+		return 0
+	}
+
+	missing := 0
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	for _, check := range checks {
+		status := "OK"
+		source := check.Source
+
+		switch {
+		case check.Err != nil:
+			status = "ERROR"
+			source = check.Err.Error()
+		case !check.Found():
+			status = "MISSING"
+			source = fmt.Sprintf("wtfutil save-secret %s, or set %s", check.Service, cfg.SecretEnvVar(config, check.Service))
+		}
+
+		// Every status is colored once, so the escape codes don't upset the alignment
+		colored := aurora.Green(status)
+		switch {
+		case !check.Enabled:
+			colored = aurora.BrightBlack(status)
+			source += " (disabled)"
+		case !check.Found():
+			colored = aurora.Red(status)
+			missing++
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", colored, check.Module, check.Service, source)
+	}
+
+	writer.Flush()
+
+	if missing > 0 {
+		fmt.Printf("\n%d enabled module(s) missing a secret\n", missing)
+		return 1
+	}
+
+	return 0
+}
+
+// DisplayList prints the services the secret providers have secrets for, never the
+// secrets themselves. It returns the exit code
+func DisplayList(config *config.Config) int {
+	listings, err := cfg.ListSecrets(config)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, listing := range listings {
+		fmt.Fprintf(writer, "%s\t%s\n", listing.Service, listing.Store)
+	}
+	writer.Flush()
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s %v\n", aurora.Red("ERROR"), err)
+		return 1
+	}
+
+	if len(listings) == 0 {
+		fmt.Println("No secrets found")
+	}
+
+	return 0
+}
+
+// Delete removes the secret for the service from the secret store. It returns the exit
+// code
+func Delete(config *config.Config, service string) int {
+	err := cfg.DeleteSecret(config, service)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Deleting secret for service %q: %s\n", service, err.Error())
+		return 1
+	}
+
+	fmt.Printf("Deleted secret for service %q\n", service)
+	return 0
+}
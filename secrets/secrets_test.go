@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+	_ "github.com/wtfutil/wtf/modules/all"
+)
+
+func Test_CheckSecrets(t *testing.T) {
+	t.Setenv("WTF_SECRET_TODOIST", "abc")
+
+	config, _ := config.ParseYaml(`
+wtf:
+  mods:
+    github:
+      enabled: true
+      baseURL: https://github.example.com/api/v3
+    todoist:
+      enabled: false
+    clocks:
+      enabled: true
+`)
+
+	checks := CheckSecrets(config)
+
+	// Where the secrets are loaded into is particular to each module's settings
+	for i := range checks {
+		assert.NotNil(t, checks[i].Target)
+		checks[i].Target = nil
+	}
+
+	assert.Equal(t, []Check{
+		{SecretLookup: cfg.SecretLookup{Module: "github", Service: "https://github.example.com/api/v3"}, Enabled: true},
+		{SecretLookup: cfg.SecretLookup{Module: "todoist", Service: "todoist", Source: "env"}, Enabled: false},
+	}, checks)
+
+	assert.False(t, checks[0].Found())
+	assert.True(t, checks[1].Found())
+}
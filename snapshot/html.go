@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+const htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>WTF</title>
+<style>
+  body { background: %[1]s; margin: 0; }
+  pre.wtf { background: %[1]s; color: %[2]s; display: inline-block; font-family: Menlo, Consolas, "DejaVu Sans Mono", monospace; line-height: 1.2; margin: 0; padding: 1em; }
+</style>
+</head>
+<body>
+<pre class="wtf">`
+
+const htmlFooter = `</pre>
+</body>
+</html>
+`
+
+// writeHTML writes the lines as a page that displays them in a preformatted block
+func writeHTML(w io.Writer, lines [][]run) error {
+	writer := bufio.NewWriter(w)
+
+	_, _ = fmt.Fprintf(writer, htmlHeader, defaultBackground, defaultForeground)
+
+	for idx, line := range lines {
+		if idx > 0 {
+			_, _ = writer.WriteString("\n")
+		}
+
+		for _, r := range line {
+			text := html.EscapeString(r.text)
+
+			css := cssStyle(r.style)
+			if css == "" {
+				_, _ = writer.WriteString(text)
+				continue
+			}
+
+			_, _ = fmt.Fprintf(writer, `<span style="%s">%s</span>`, css, text)
+		}
+	}
+
+	_, _ = writer.WriteString(htmlFooter)
+
+	return writer.Flush()
+}
+
+// cssStyle returns the CSS declarations that display text in the style, leaving out the
+// ones the page's defaults already cover
+func cssStyle(style tcell.Style) string {
+	_, _, attrs := style.Decompose()
+	foreground, background := colors(style)
+
+	declarations := []string{}
+
+	if foreground != defaultForeground {
+		declarations = append(declarations, "color:"+foreground)
+	}
+
+	if background != defaultBackground {
+		declarations = append(declarations, "background-color:"+background)
+	}
+
+	if attrs&tcell.AttrBold != 0 {
+		declarations = append(declarations, "font-weight:bold")
+	}
+
+	if attrs&tcell.AttrItalic != 0 {
+		declarations = append(declarations, "font-style:italic")
+	}
+
+	if attrs&tcell.AttrDim != 0 {
+		declarations = append(declarations, "opacity:0.6")
+	}
+
+	if decoration := textDecoration(attrs); decoration != "" {
+		declarations = append(declarations, "text-decoration:"+decoration)
+	}
+
+	return strings.Join(declarations, ";")
+}
+
+// textDecoration returns the lines drawn through or under text with the attributes
+func textDecoration(attrs tcell.AttrMask) string {
+	decorations := []string{}
+
+	if attrs&tcell.AttrUnderline != 0 {
+		decorations = append(decorations, "underline")
+	}
+
+	if attrs&tcell.AttrStrikeThrough != 0 {
+		decorations = append(decorations, "line-through")
+	}
+
+	return strings.Join(decorations, " ")
+}
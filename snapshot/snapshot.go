@@ -0,0 +1,155 @@
+// Package snapshot writes what an off-screen terminal displays as plain text, ANSI
+// escape sequences, HTML or SVG, so that a dashboard can be shared without a terminal,
+// posted to a status page or compared against a golden file in tests.
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// Format is the kind of file a snapshot is written as
+type Format string
+
+const (
+	ANSI Format = "ansi"
+	HTML Format = "html"
+	SVG  Format = "svg"
+	Text Format = "txt"
+)
+
+const (
+	// The colors of the cells that use the terminal's defaults, for the formats that
+	// can't leave them to the terminal
+	defaultBackground = "#000000"
+	defaultForeground = "#d0d0d0"
+)
+
+// run is a stretch of cells on one line that are displayed in the same style
+type run struct {
+	style tcell.Style
+	text  string
+	width int
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Formats returns the names of the formats snapshots can be written as
+func Formats() []string {
+	return []string{string(ANSI), string(HTML), string(SVG), string(Text)}
+}
+
+// FormatFor returns the format a file's extension suggests, or Text if it doesn't
+// suggest one
+func FormatFor(filePath string) Format {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".ans", ".ansi":
+		return ANSI
+	case ".htm", ".html":
+		return HTML
+	case ".svg":
+		return SVG
+	}
+
+	return Text
+}
+
+// ParseFormat returns the format with the given name
+func ParseFormat(name string) (Format, error) {
+	for _, format := range Formats() {
+		if strings.EqualFold(name, format) {
+			return Format(format), nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a snapshot format, use one of %s", name, strings.Join(Formats(), ", "))
+}
+
+// Write writes what the screen displays in the format. The screen's contents are those of
+// its last Show
+func Write(w io.Writer, screen tcell.SimulationScreen, format Format) error {
+	lines := capture(screen)
+
+	switch format {
+	case ANSI:
+		return writeANSI(w, lines)
+	case HTML:
+		return writeHTML(w, lines)
+	case SVG:
+		return writeSVG(w, lines)
+	case Text:
+		return writeText(w, lines)
+	}
+
+	return fmt.Errorf("%q is not a snapshot format, use one of %s", format, strings.Join(Formats(), ", "))
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// capture breaks each line of the screen into runs of cells with the same style
+func capture(screen tcell.SimulationScreen) [][]run {
+	cells, width, height := screen.GetContents()
+	lines := make([][]run, 0, height)
+
+	for y := 0; y < height; y++ {
+		line := []run{}
+
+		for x := 0; x < width; x++ {
+			cell := cells[y*width+x]
+
+			text := string(cell.Runes)
+			cellWidth := runewidth.StringWidth(text)
+			if cellWidth < 1 || strings.TrimSpace(text) == "" {
+				text = " "
+				cellWidth = 1
+			}
+
+			// A wide character covers the cell after it too, which holds nothing to display
+			if cellWidth > 1 {
+				x += cellWidth - 1
+			}
+
+			if last := len(line) - 1; last >= 0 && line[last].style == cell.Style {
+				line[last].text += text
+				line[last].width += cellWidth
+				continue
+			}
+
+			line = append(line, run{style: cell.Style, text: text, width: cellWidth})
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// colors returns the CSS colors a style displays in, swapped if it's reversed
+func colors(style tcell.Style) (foreground, background string) {
+	fg, bg, attrs := style.Decompose()
+
+	foreground = cssColor(fg, defaultForeground)
+	background = cssColor(bg, defaultBackground)
+
+	if attrs&tcell.AttrReverse != 0 {
+		return background, foreground
+	}
+
+	return foreground, background
+}
+
+// cssColor returns the color as a CSS hex color, or the fallback if it's the terminal's
+// default color
+func cssColor(color tcell.Color, fallback string) string {
+	hex := color.Hex()
+	if hex < 0 {
+		return fallback
+	}
+
+	return fmt.Sprintf("#%06x", hex)
+}
@@ -0,0 +1,90 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testScreen returns a screen that displays a green, bold title over a line with a wide
+// character on a blue background
+func testScreen(t *testing.T) tcell.SimulationScreen {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+	t.Cleanup(screen.Fini)
+
+	screen.SetSize(8, 2)
+	screen.Clear()
+
+	title := tcell.StyleDefault.Foreground(tcell.ColorGreen).Bold(true)
+	for x, r := range "a<b>" {
+		screen.SetContent(x, 0, r, nil, title)
+	}
+
+	highlight := tcell.StyleDefault.Background(tcell.NewRGBColor(0, 0, 255))
+	screen.SetContent(0, 1, '日', nil, highlight)
+	screen.SetContent(2, 1, 'x', nil, highlight)
+
+	screen.Show()
+
+	return screen
+}
+
+func write(t *testing.T, format Format) string {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, Write(buf, testScreen(t), format))
+
+	return buf.String()
+}
+
+func Test_Write(t *testing.T) {
+	t.Run("as text", func(t *testing.T) {
+		assert.Equal(t, "a<b>\n日x\n", write(t, Text))
+	})
+
+	t.Run("as ANSI", func(t *testing.T) {
+		assert.Equal(
+			t,
+			"\x1b[0;1;38;5;2ma<b>\x1b[0m    \x1b[0m\n\x1b[0;48;2;0;0;255m日x\x1b[0m     \x1b[0m\n",
+			write(t, ANSI),
+		)
+	})
+
+	t.Run("as HTML", func(t *testing.T) {
+		actual := write(t, HTML)
+
+		assert.Contains(t, actual, `<span style="color:#008000;font-weight:bold">a&lt;b&gt;</span>    `+"\n")
+		assert.Contains(t, actual, `<span style="background-color:#0000ff">日x</span>     </pre>`)
+	})
+
+	t.Run("as SVG", func(t *testing.T) {
+		actual := write(t, SVG)
+
+		assert.Contains(t, actual, `width="67.2" height="36"`)
+		assert.Contains(t, actual, `<text x="0" y="14" textLength="33.6" lengthAdjust="spacingAndGlyphs" xml:space="preserve" fill="#008000" font-weight="bold">a&lt;b&gt;</text>`)
+		assert.Contains(t, actual, `<rect x="0" y="18" width="25.2" height="18" fill="#0000ff"/>`)
+	})
+
+	t.Run("in an unknown format", func(t *testing.T) {
+		assert.Error(t, Write(&bytes.Buffer{}, testScreen(t), Format("png")))
+	})
+}
+
+func Test_FormatFor(t *testing.T) {
+	assert.Equal(t, HTML, FormatFor("status/index.html"))
+	assert.Equal(t, SVG, FormatFor("dashboard.SVG"))
+	assert.Equal(t, ANSI, FormatFor("dashboard.ans"))
+	assert.Equal(t, Text, FormatFor("dashboard.golden"))
+	assert.Equal(t, Text, FormatFor(""))
+}
+
+func Test_ParseFormat(t *testing.T) {
+	format, err := ParseFormat("HTML")
+	assert.NoError(t, err)
+	assert.Equal(t, HTML, format)
+
+	_, err = ParseFormat("png")
+	assert.EqualError(t, err, `"png" is not a snapshot format, use one of ansi, html, svg, txt`)
+}
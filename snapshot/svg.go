@@ -0,0 +1,116 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	// The size of a cell, and of the font in it, in pixels
+	cellHeight = 18
+	cellWidth  = 8.4
+	fontSize   = 14
+
+	// baseline is how far below the top of a cell its text sits
+	baseline = 14
+)
+
+// writeSVG writes the lines as an image, with each run of text stretched to the width
+// of its cells so that the columns line up whatever monospace font is used
+func writeSVG(w io.Writer, lines [][]run) error {
+	writer := bufio.NewWriter(w)
+
+	columns := 0
+	for _, line := range lines {
+		width := 0
+		for _, r := range line {
+			width += r.width
+		}
+
+		if width > columns {
+			columns = width
+		}
+	}
+
+	width := pixels(columns)
+	height := len(lines) * cellHeight
+
+	_, _ = fmt.Fprintf(
+		writer,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%d" viewBox="0 0 %s %d" font-family="Menlo, Consolas, 'DejaVu Sans Mono', monospace" font-size="%d">`+"\n",
+		width, height, width, height, fontSize,
+	)
+	_, _ = fmt.Fprintf(writer, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", defaultBackground)
+
+	for row, line := range lines {
+		y := row * cellHeight
+		column := 0
+
+		for _, r := range line {
+			x := pixels(column)
+			runWidth := pixels(r.width)
+			column += r.width
+
+			foreground, background := colors(r.style)
+
+			if background != defaultBackground {
+				_, _ = fmt.Fprintf(
+					writer,
+					`<rect x="%s" y="%d" width="%s" height="%d" fill="%s"/>`+"\n",
+					x, y, runWidth, cellHeight, background,
+				)
+			}
+
+			if strings.TrimSpace(r.text) == "" {
+				continue
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				`<text x="%s" y="%d" textLength="%s" lengthAdjust="spacingAndGlyphs" xml:space="preserve" fill="%s"%s>%s</text>`+"\n",
+				x, y+baseline, runWidth, foreground, svgAttributes(r.style), html.EscapeString(r.text),
+			)
+		}
+	}
+
+	_, _ = writer.WriteString("</svg>\n")
+
+	return writer.Flush()
+}
+
+// pixels returns how wide the given number of cells is, to a tenth of a pixel
+func pixels(cells int) string {
+	return strconv.FormatFloat(math.Round(float64(cells)*cellWidth*10)/10, 'f', -1, 64)
+}
+
+// svgAttributes returns the attributes that display text in the style, besides its color
+func svgAttributes(style tcell.Style) string {
+	_, _, attrs := style.Decompose()
+
+	attributes := ""
+
+	if attrs&tcell.AttrBold != 0 {
+		attributes += ` font-weight="bold"`
+	}
+
+	if attrs&tcell.AttrItalic != 0 {
+		attributes += ` font-style="italic"`
+	}
+
+	if attrs&tcell.AttrDim != 0 {
+		attributes += ` opacity="0.6"`
+	}
+
+	if decoration := textDecoration(attrs); decoration != "" {
+		attributes += fmt.Sprintf(` text-decoration="%s"`, decoration)
+	}
+
+	return attributes
+}
@@ -0,0 +1,98 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// writeANSI writes the lines with the escape sequences that color them in a terminal. Colors
+// from the terminal's palette are written as palette colors, so they keep following the
+// terminal's theme
+func writeANSI(w io.Writer, lines [][]run) error {
+	writer := bufio.NewWriter(w)
+
+	for _, line := range lines {
+		for _, r := range line {
+			_, _ = writer.WriteString(sgr(r.style))
+			_, _ = writer.WriteString(r.text)
+		}
+
+		_, _ = writer.WriteString("\x1b[0m\n")
+	}
+
+	return writer.Flush()
+}
+
+// writeText writes the lines without their colors, trimming the spaces they end with
+func writeText(w io.Writer, lines [][]run) error {
+	writer := bufio.NewWriter(w)
+
+	for _, line := range lines {
+		text := ""
+		for _, r := range line {
+			text += r.text
+		}
+
+		_, _ = writer.WriteString(strings.TrimRight(text, " "))
+		_, _ = writer.WriteString("\n")
+	}
+
+	return writer.Flush()
+}
+
+// sgr returns the escape sequence that displays text in the style
+func sgr(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+
+	params := []string{"0"}
+
+	for _, attr := range []struct {
+		mask  tcell.AttrMask
+		param string
+	}{
+		{tcell.AttrBold, "1"},
+		{tcell.AttrDim, "2"},
+		{tcell.AttrItalic, "3"},
+		{tcell.AttrUnderline, "4"},
+		{tcell.AttrBlink, "5"},
+		{tcell.AttrReverse, "7"},
+		{tcell.AttrStrikeThrough, "9"},
+	} {
+		if attrs&attr.mask != 0 {
+			params = append(params, attr.param)
+		}
+	}
+
+	if param := sgrColor(fg); param != "" {
+		params = append(params, "38;"+param)
+	}
+
+	if param := sgrColor(bg); param != "" {
+		params = append(params, "48;"+param)
+	}
+
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// sgrColor returns the parameters that select the color, after the 38 or 48 that says
+// whether it's the foreground or background, or "" for the terminal's default color
+func sgrColor(color tcell.Color) string {
+	if !color.Valid() {
+		return ""
+	}
+
+	if color.IsRGB() {
+		r, g, b := color.RGB()
+		return fmt.Sprintf("2;%d;%d;%d", r, g, b)
+	}
+
+	if idx := color - tcell.ColorValid; idx < 256 {
+		return fmt.Sprintf("5;%d", idx)
+	}
+
+	return ""
+}
@@ -0,0 +1,196 @@
+// Package state remembers how the UI was left, such as the items that were selected, the
+// sources and dashboard that were displayed and the widget that had focus, so that WTF
+// comes back the same way after a restart or a reload. Values are named by a scope, the
+// widget or dashboard they belong to, and a key. They're kept in memory and written to a
+// JSON file shortly after they change.
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/wtfutil/wtf/logger"
+)
+
+// saveDelay is how long changes are collected before they're written, so that scrolling
+// through a list doesn't write the file for every item
+const saveDelay = 2 * time.Second
+
+var (
+	stateMutex = &sync.Mutex{}
+
+	dirty     = false
+	path      = ""
+	saveTimer *time.Timer
+	values    = map[string]map[string]json.RawMessage{}
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// Configure sets the file the state is stored in and reads what was stored there. An
+// empty path keeps the state in memory only, so nothing is remembered across restarts.
+// Changes that haven't been written yet are written to the previous file first
+func Configure(filePath string) {
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+
+	if filePath == path {
+		return
+	}
+
+	if err := flush(); err != nil {
+		logger.Warn(fmt.Sprintf("The UI state can't be saved: %s", err))
+	}
+
+	dirty = false
+	path = filePath
+	values = map[string]map[string]json.RawMessage{}
+
+	if path == "" {
+		return
+	}
+
+	stored, err := read(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Warn(fmt.Sprintf("The UI state can't be restored: %s", err))
+		}
+		return
+	}
+
+	values = stored
+}
+
+// Flush writes any changes that haven't been written yet. It's called when the app stops
+func Flush() error {
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+
+	return flush()
+}
+
+// Load reads the value saved under the scope and key into value. It returns FALSE, and
+// leaves value alone, if nothing was saved there or it can't be read into value
+func Load(scope, key string, value interface{}) bool {
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+
+	encoded, ok := values[scope][key]
+	if !ok {
+		return false
+	}
+
+	return json.Unmarshal(encoded, value) == nil
+}
+
+// Save remembers value under the scope and key. It's written to the file a couple of
+// seconds later, along with any other changes made in the meantime
+func Save(scope, key string, value interface{}) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("The UI state %s %s can't be saved: %s", scope, key, err))
+		return
+	}
+
+	stateMutex.Lock()
+	defer stateMutex.Unlock()
+
+	if bytes.Equal(values[scope][key], encoded) {
+		return
+	}
+
+	if values[scope] == nil {
+		values[scope] = map[string]json.RawMessage{}
+	}
+	values[scope][key] = encoded
+
+	if path == "" {
+		return
+	}
+
+	dirty = true
+
+	if saveTimer == nil {
+		saveTimer = time.AfterFunc(saveDelay, flushLater)
+	} else {
+		saveTimer.Reset(saveDelay)
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// flush writes any changes that haven't been written yet. The caller holds stateMutex
+func flush() error {
+	if saveTimer != nil {
+		saveTimer.Stop()
+	}
+
+	if !dirty || path == "" {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := write(path, contents); err != nil {
+		return err
+	}
+
+	dirty = false
+
+	return nil
+}
+
+// flushLater writes the changes when the save delay runs out
+func flushLater() {
+	if err := Flush(); err != nil {
+		logger.Warn(fmt.Sprintf("The UI state can't be saved: %s", err))
+	}
+}
+
+func read(filePath string) (map[string]map[string]json.RawMessage, error) {
+	contents, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	stored := map[string]map[string]json.RawMessage{}
+	if err := json.Unmarshal(contents, &stored); err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// write replaces the file's contents through a temporary file, so that a crash halfway
+// through doesn't lose what was stored before
+func write(filePath string, contents []byte) error {
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(dir, filepath.Base(filePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(contents); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), filePath)
+}
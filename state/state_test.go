@@ -0,0 +1,110 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// configure stores the state in a temporary file for the length of a test
+func configure(t *testing.T) string {
+	filePath := filepath.Join(t.TempDir(), "state.json")
+
+	Configure(filePath)
+	t.Cleanup(func() { Configure("") })
+
+	return filePath
+}
+
+func Test_SaveAndLoad(t *testing.T) {
+	configure(t)
+
+	Save("jira-jira", "selected", 3)
+	Save("jira-jira", "source", "backlog")
+
+	selected := -1
+	assert.True(t, Load("jira-jira", "selected", &selected))
+	assert.Equal(t, 3, selected)
+
+	source := ""
+	assert.True(t, Load("jira-jira", "source", &source))
+	assert.Equal(t, "backlog", source)
+
+	assert.False(t, Load("jira-jira", "focused", &source))
+	assert.False(t, Load("github-github", "selected", &selected))
+	assert.Equal(t, 3, selected)
+
+	// A value that can't be read into the one given is left out
+	assert.False(t, Load("jira-jira", "source", &selected))
+}
+
+func Test_Flush(t *testing.T) {
+	filePath := configure(t)
+
+	assert.NoError(t, Flush())
+	_, err := os.Stat(filePath)
+	assert.True(t, os.IsNotExist(err), "nothing is written until something is saved")
+
+	Save("app", "dashboard", "work")
+	assert.NoError(t, Flush())
+
+	// Reading the file again brings the value back
+	Configure("")
+	dashboard := ""
+	assert.False(t, Load("app", "dashboard", &dashboard))
+
+	Configure(filePath)
+	assert.True(t, Load("app", "dashboard", &dashboard))
+	assert.Equal(t, "work", dashboard)
+}
+
+func Test_Configure(t *testing.T) {
+	t.Run("with an unreadable file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "state.json")
+		assert.NoError(t, os.WriteFile(filePath, []byte("{not json"), 0o600))
+
+		Configure(filePath)
+		defer Configure("")
+
+		Save("app", "dashboard", "home")
+		assert.NoError(t, Flush())
+
+		dashboard := ""
+		Configure("")
+		Configure(filePath)
+		assert.True(t, Load("app", "dashboard", &dashboard))
+		assert.Equal(t, "home", dashboard)
+	})
+
+	t.Run("with unwritten changes", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "state.json")
+
+		Configure(filePath)
+		defer Configure("")
+
+		Save("app", "dashboard", "home")
+
+		// Switching files writes the changes to the previous one first
+		Configure(filepath.Join(t.TempDir(), "other.json"))
+
+		dashboard := ""
+		assert.False(t, Load("app", "dashboard", &dashboard))
+
+		Configure(filePath)
+		assert.True(t, Load("app", "dashboard", &dashboard))
+		assert.Equal(t, "home", dashboard)
+	})
+
+	t.Run("without a file", func(t *testing.T) {
+		Configure("")
+
+		Save("app", "dashboard", "home")
+		assert.NoError(t, Flush())
+
+		dashboard := ""
+		assert.True(t, Load("app", "dashboard", &dashboard))
+		assert.Equal(t, "home", dashboard)
+	})
+}
@@ -1,22 +1,26 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/cfg"
+)
 
 // ColorizePercent provides a standard way to colorize percentages for which
-// large numbers are good (green) and small numbers are bad (red).
-func ColorizePercent(percent float64) string {
+// large numbers are good (ok) and small numbers are bad (error).
+func ColorizePercent(percent float64, roles cfg.RoleTheme) string {
 	var color string
 
 	switch {
 	case percent >= 70:
-		color = "green"
+		color = roles.Ok
 	case percent >= 35:
-		color = "yellow"
+		color = roles.Warn
 	case percent < 0:
-		color = "grey"
+		color = roles.Muted
 	default:
-		color = "red"
+		color = roles.Error
 	}
 
-	return fmt.Sprintf("[%s]%v[%s]", color, percent, "white")
+	return fmt.Sprintf("[%s]%v[-]", color, percent)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
 )
 
 func Test_ColorizePercent(t *testing.T) {
@@ -15,28 +16,28 @@ func Test_ColorizePercent(t *testing.T) {
 		{
 			name:     "with high percent",
 			percent:  70,
-			expected: "[green]70[white]",
+			expected: "[green]70[-]",
 		},
 		{
 			name:     "with medium percent",
 			percent:  35,
-			expected: "[yellow]35[white]",
+			expected: "[yellow]35[-]",
 		},
 		{
 			name:     "with low percent",
 			percent:  1,
-			expected: "[red]1[white]",
+			expected: "[red]1[-]",
 		},
 		{
 			name:     "with negative percent",
 			percent:  -5,
-			expected: "[grey]-5[white]",
+			expected: "[gray]-5[-]",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := ColorizePercent(tt.percent)
+			actual := ColorizePercent(tt.percent, cfg.NewDefaultColorTheme().RoleTheme)
 			assert.Equal(t, tt.expected, actual)
 		})
 	}
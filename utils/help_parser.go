@@ -39,6 +39,16 @@ func HelpFromInterface(item interface{}) string {
 	return result
 }
 
+// LowercaseTitle returns the title with its first letter lowercased. This is how a settings
+// struct field's name is turned into the name of its configuration key
+func LowercaseTitle(title string) string {
+	if title == "" {
+		return ""
+	}
+	r, n := utf8.DecodeRuneInString(title)
+	return string(unicode.ToLower(r)) + title[n:]
+}
+
 // StripColorTags removes tcell color tags from a given string
 func StripColorTags(input string) string {
 	openColorRegex := regexp.MustCompile(`\[.*?\]`)
@@ -62,7 +72,7 @@ func helpFromValue(field reflect.StructField) string {
 
 	values := field.Tag.Get("values")
 	if help != "" {
-		result += "\n\n " + lowercaseTitle(field.Name)
+		result += "\n\n " + LowercaseTitle(field.Name)
 		result += "\n " + help
 
 		if values != "" {
@@ -72,11 +82,3 @@ func helpFromValue(field reflect.StructField) string {
 
 	return result
 }
-
-func lowercaseTitle(title string) string {
-	if title == "" {
-		return ""
-	}
-	r, n := utf8.DecodeRuneInString(title)
-	return string(unicode.ToLower(r)) + title[n:]
-}
@@ -0,0 +1,31 @@
+package validate
+
+import "fmt"
+
+/* -------------------- Unexported Functions -------------------- */
+
+// checkOverlaps reports enabled modules whose positions in the grid overlap. Each
+// overlap is reported once, on the module that appears later in the file
+func checkOverlaps(probs *problems, positions []modulePosition) {
+	for i, later := range positions {
+		for _, earlier := range positions[:i] {
+			if !overlaps(earlier, later) {
+				continue
+			}
+
+			probs.error(
+				later.line,
+				fmt.Sprintf("wtf.mods.%s.position", later.name),
+				"overlaps the position of %q",
+				earlier.name,
+			)
+		}
+	}
+}
+
+func overlaps(a, b modulePosition) bool {
+	return a.settings.Left < b.settings.Left+b.settings.Width &&
+		b.settings.Left < a.settings.Left+a.settings.Width &&
+		a.settings.Top < b.settings.Top+b.settings.Height &&
+		b.settings.Top < a.settings.Top+a.settings.Height
+}
@@ -0,0 +1,238 @@
+package validate
+
+import (
+	"github.com/wtfutil/wtf/app"
+	"github.com/wtfutil/wtf/cfg"
+	"gopkg.in/yaml.v3"
+)
+
+// booleanKeys are the single-character keys that YAML 1.1 reads as booleans
+var booleanKeys = []string{"n", "N", "y", "Y"}
+
+// boundKey is a key an action is bound to in a `keys` section
+type boundKey struct {
+	action string
+	global bool
+	key    string
+	line   int
+	path   string
+}
+
+// keyBindings are the actions rebound in a `keys` section, in the order they appear
+type keyBindings struct {
+	actions []string
+	keys    map[string][]boundKey
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// checkGlobalKeyBindings validates the `wtf.keys` section and returns the bindings in it
+// so that each module's bindings can be checked against them. Two app-wide actions can't
+// share a key, and neither can two widget actions
+func checkGlobalKeyBindings(probs *problems, wtfNode *yaml.Node) keyBindings {
+	bindings := keyBindings{keys: map[string][]boundKey{}}
+
+	keysPair := lookup(wtfNode, "keys")
+	if keysPair == nil {
+		return bindings
+	}
+
+	bindings = readKeyBindings(probs, "wtf.keys", keysPair.value)
+
+	appActions := map[string]bool{}
+	appKeys := []boundKey{}
+	for _, globalAction := range app.GlobalKeyActions() {
+		appActions[globalAction.Action] = true
+		appKeys = append(appKeys, bindings.effective(globalAction.Action, globalAction.Keys)...)
+	}
+
+	widgetKeys := []boundKey{}
+	for _, action := range bindings.actions {
+		if !appActions[action] {
+			widgetKeys = append(widgetKeys, bindings.keys[action]...)
+		}
+	}
+
+	checkKeyConflicts(probs, appKeys, false)
+	checkKeyConflicts(probs, widgetKeys, false)
+	checkShadowedKeys(probs, bindings, widgetKeys)
+
+	return bindings
+}
+
+// checkModuleKeyBindings validates a module's `keys` section. Its bindings, along with
+// the widget bindings in `wtf.keys` that it doesn't override, can't share a key or use a
+// key that the app always handles itself
+func checkModuleKeyBindings(probs *problems, path string, modNode *yaml.Node, globals keyBindings) {
+	keysPair := lookup(modNode, "keys")
+	if keysPair == nil {
+		return
+	}
+
+	bindings := readKeyBindings(probs, path+".keys", keysPair.value)
+
+	appActions := map[string]bool{}
+	for _, globalAction := range app.GlobalKeyActions() {
+		appActions[globalAction.Action] = true
+	}
+
+	widgetKeys := []boundKey{}
+	for _, action := range bindings.actions {
+		widgetKeys = append(widgetKeys, bindings.keys[action]...)
+	}
+	for _, action := range globals.actions {
+		if _, overridden := bindings.keys[action]; !overridden && !appActions[action] {
+			widgetKeys = append(widgetKeys, globals.keys[action]...)
+		}
+	}
+
+	// Conflicts between two global bindings were already reported for wtf.keys
+	checkKeyConflicts(probs, widgetKeys, true)
+
+	checkShadowedKeys(probs, globals, bindings.allKeys())
+}
+
+// checkKeyConflicts reports keys bound to more than one action. The problem is reported
+// on the binding that appears later. With skipGlobal, conflicts between two bindings in
+// wtf.keys are left out
+func checkKeyConflicts(probs *problems, keys []boundKey, skipGlobal bool) {
+	owners := map[string]boundKey{}
+
+	for _, key := range keys {
+		owner, taken := owners[key.key]
+		if !taken {
+			owners[key.key] = key
+			continue
+		}
+
+		if owner.action == key.action || (skipGlobal && owner.global && key.global) {
+			continue
+		}
+
+		later := key
+		if later.line < owner.line {
+			later, owner = owner, later
+		}
+
+		probs.error(later.line, later.path, "%s is already bound to %q", key.key, owner.action)
+	}
+}
+
+// checkShadowedKeys reports widget keys that will never reach the widget, because the
+// app handles them itself
+func checkShadowedKeys(probs *problems, globals keyBindings, keys []boundKey) {
+	appKeys := map[string]string{}
+	for _, globalAction := range app.GlobalKeyActions() {
+		for _, key := range globals.effective(globalAction.Action, globalAction.Keys) {
+			// Characters only reach the app when no widget has focus
+			if len([]rune(key.key)) == 1 {
+				continue
+			}
+
+			appKeys[key.key] = globalAction.Action
+		}
+	}
+
+	for _, key := range keys {
+		if action, ok := appKeys[key.key]; ok {
+			probs.error(key.line, key.path, "%s is used by the app-wide %q action, so the widget never sees it", key.key, action)
+		}
+	}
+}
+
+// readKeyBindings parses a `keys` section, reporting values that aren't keys
+func readKeyBindings(probs *problems, path string, node *yaml.Node) keyBindings {
+	bindings := keyBindings{keys: map[string][]boundKey{}}
+
+	if node == nil || node.Kind != yaml.MappingNode {
+		probs.error(lineOf(node), path, "expected a map of action names to keys, found %s", kindName(node))
+		return bindings
+	}
+
+	for _, pair := range pairs(node) {
+		action := pair.key.Value
+		actionPath := path + "." + action
+
+		bindings.actions = append(bindings.actions, action)
+		bindings.keys[action] = []boundKey{}
+
+		keyNodes := []*yaml.Node{pair.value}
+		if pair.value != nil && pair.value.Kind == yaml.SequenceNode {
+			keyNodes = pair.value.Content
+		}
+
+		for _, keyNode := range keyNodes {
+			keyNode = resolve(keyNode)
+
+			// An empty value unbinds the action
+			if isNull(keyNode) || (isScalar(keyNode) && keyNode.Value == "") {
+				continue
+			}
+
+			if !isScalar(keyNode) {
+				probs.error(lineOf(keyNode), actionPath, "expected a key name or a list of key names, found %s", kindName(keyNode))
+				continue
+			}
+
+			// WTF's YAML parser reads these as booleans unless they're quoted
+			if keyNode.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) == 0 && contains(booleanKeys, keyNode.Value) {
+				probs.error(keyNode.Line, actionPath, "%s is read as true or false, write it as \"%s\"", keyNode.Value, keyNode.Value)
+				continue
+			}
+
+			_, _, canonical, err := cfg.ParseKey(keyNode.Value)
+			if err != nil {
+				probs.error(keyNode.Line, actionPath, "%v", err)
+				continue
+			}
+
+			bindings.keys[action] = append(bindings.keys[action], boundKey{
+				action: action,
+				global: path == "wtf.keys",
+				key:    canonical,
+				line:   keyNode.Line,
+				path:   actionPath,
+			})
+		}
+	}
+
+	return bindings
+}
+
+// allKeys returns every key in the bindings
+func (bindings keyBindings) allKeys() []boundKey {
+	keys := []boundKey{}
+	for _, action := range bindings.actions {
+		keys = append(keys, bindings.keys[action]...)
+	}
+
+	return keys
+}
+
+// effective returns the keys an action is bound to: the ones in the bindings if it was
+// rebound, otherwise its defaults
+func (bindings keyBindings) effective(action string, defaults []string) []boundKey {
+	if keys, ok := bindings.keys[action]; ok {
+		return keys
+	}
+
+	keys := []boundKey{}
+	for _, name := range defaults {
+		_, _, canonical, err := cfg.ParseKey(name)
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, boundKey{action: action, global: true, key: canonical, path: "wtf.keys"})
+	}
+
+	return keys
+}
+
+func lineOf(node *yaml.Node) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.Line
+}
@@ -0,0 +1,416 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/schema"
+	"github.com/wtfutil/wtf/utils"
+	"gopkg.in/yaml.v3"
+)
+
+var positionKeys = []string{"height", "left", "top", "width"}
+
+// modulePosition is where an enabled module is displayed in the grid
+type modulePosition struct {
+	line     int
+	name     string
+	settings cfg.PositionSettings
+}
+
+// settingsField is a module-specific setting, described by its settings struct field
+type settingsField struct {
+	key   string
+	field reflect.StructField
+	value reflect.Value
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// checkModule validates a single module's configuration. If the module is enabled and
+// has a valid position, that position is returned so that overlaps can be detected
+func checkModule(probs *problems, globalConfig *config.Config, modPair yamlPair, globalKeys keyBindings) *modulePosition {
+	name := modPair.key.Value
+	path := "wtf.mods." + name
+	modNode := modPair.value
+
+	if modNode == nil || modNode.Kind != yaml.MappingNode {
+		probs.error(modPair.key.Line, path, "expected a map of module settings, found %s", kindName(modNode))
+		return nil
+	}
+
+	checkCommonKeys(probs, path, modNode)
+	checkModuleKeyBindings(probs, path, modNode, globalKeys)
+
+	settings, err := makeSettings(globalConfig, name)
+	if err != nil {
+		probs.error(modPair.key.Line, path, "the module could not be loaded: %v", err)
+		return nil
+	}
+
+	common := commonOf(settings)
+	if common == nil || isUnknownModule(settings) {
+		line := modPair.key.Line
+		if typePair := lookup(modNode, "type"); typePair != nil {
+			line = typePair.key.Line
+		}
+
+		moduleType := name
+		if common != nil {
+			moduleType = common.Type
+		}
+
+		probs.error(line, path+".type", "unknown module type %q", moduleType)
+		return nil
+	}
+
+	checkModuleKeys(probs, path, modPair, settingsFields(settings))
+
+	return checkPosition(probs, globalConfig, path, modPair, common)
+}
+
+// checkCommonKeys validates the settings that every module has
+func checkCommonKeys(probs *problems, path string, modNode *yaml.Node) {
+	for _, pair := range pairs(modNode) {
+		key := pair.key.Value
+		keyPath := path + "." + key
+		value := pair.value
+
+		switch key {
+		case "border", "cache", "enabled", "focusable":
+			if !isBool(value) {
+				probs.error(value.Line, keyPath, "expected true or false, found %s", kindName(value))
+			}
+		case "focusChar":
+			if !isInt(value) {
+				probs.error(value.Line, keyPath, "expected a number, found %s", kindName(value))
+			}
+		case "colors", "position":
+			if value.Kind != yaml.MappingNode {
+				probs.error(value.Line, keyPath, "expected a map, found %s", kindName(value))
+			}
+		case "refreshInterval":
+			checkDuration(probs, keyPath, value)
+		case "title", "type":
+			if !isScalar(value) {
+				probs.error(value.Line, keyPath, "expected a string, found %s", kindName(value))
+			}
+		}
+	}
+}
+
+// checkModuleKeys validates the module-specific settings against the module's settings
+// struct. Keys that don't match any setting are reported as warnings, because not every
+// setting a module reads is described by its settings struct. The exception is keys
+// that look like a misspelling of one of the common settings
+func checkModuleKeys(probs *problems, path string, modPair yamlPair, fields []settingsField) {
+	modNode := modPair.value
+	commonKeys := schema.CommonModuleKeys()
+	fieldsByKey := map[string]settingsField{}
+	known := append([]string{}, commonKeys...)
+
+	for _, field := range fields {
+		fieldsByKey[strings.ToLower(field.key)] = field
+		known = append(known, field.key)
+	}
+
+	for _, pair := range pairs(modNode) {
+		key := pair.key.Value
+		keyPath := path + "." + key
+
+		if contains(commonKeys, key) {
+			continue
+		}
+
+		if field, ok := fieldsByKey[strings.ToLower(key)]; ok {
+			checkType(probs, keyPath, pair.value, field.field.Type)
+			continue
+		}
+
+		suggestion := suggest(key, known)
+
+		switch {
+		case contains(commonKeys, suggestion):
+			probs.error(pair.key.Line, keyPath, "unknown key %q, did you mean %q?", key, suggestion)
+		case suggestion != "":
+			probs.warning(pair.key.Line, keyPath, "unknown key %q, did you mean %q?", key, suggestion)
+		default:
+			probs.warning(pair.key.Line, keyPath, "unknown key %q", key)
+		}
+	}
+
+	for _, field := range fields {
+		if contains(commonKeys, field.key) || lookupFold(modNode, field.key) != nil {
+			continue
+		}
+
+		// The module may have found a value somewhere else, such as an environment variable
+		if !field.value.IsZero() {
+			continue
+		}
+
+		checkMissing(probs, path, modPair.key.Line, field)
+	}
+}
+
+// checkMissing reports a documented setting that has no value. Settings explicitly marked
+// `optional:"false"` are errors, settings that simply aren't marked as optional or as
+// having a default are warnings, since many of those are optional in practice
+func checkMissing(probs *problems, path string, line int, field settingsField) {
+	tag := field.field.Tag
+
+	if tag.Get("help") == "" || tag.Get("default") != "" {
+		return
+	}
+
+	// Only fields whose zero value means "not set" are considered, a missing boolean
+	// or number is indistinguishable from false or zero
+	switch field.field.Type.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+	default:
+		return
+	}
+
+	optional, err := strconv.ParseBool(tag.Get("optional"))
+
+	switch {
+	case err != nil:
+		probs.warning(line, path, "missing key %q, which isn't marked as optional: %s", field.key, tag.Get("help"))
+	case !optional:
+		probs.error(line, path, "missing required key %q: %s", field.key, tag.Get("help"))
+	}
+}
+
+// checkPosition validates the module's position in the grid, and in each of the layouts
+// it sets one for, and returns the grid position if it's valid and the module is enabled
+func checkPosition(probs *problems, globalConfig *config.Config, path string, modPair yamlPair, common *cfg.Common) *modulePosition {
+	if layoutsPair := lookup(modPair.value, "layouts"); layoutsPair != nil {
+		for _, pair := range pairs(layoutsPair.value) {
+			name := pair.key.Value
+			checkPositionKeys(probs, path+".layouts."+name, pair, common.Layouts[name])
+		}
+	}
+
+	positionPair := lookup(modPair.value, "position")
+	if positionPair == nil {
+		// Without a grid the modules are placed by a flow layout, and don't need positions
+		if len(globalConfig.UList("wtf.grid.columns")) > 0 && len(globalConfig.UList("wtf.grid.rows")) > 0 {
+			probs.warning(modPair.key.Line, path, "missing key \"position\", the module isn't displayed in the grid")
+		}
+		return nil
+	}
+
+	if !checkPositionKeys(probs, path+".position", *positionPair, common.PositionSettings) {
+		return nil
+	}
+
+	enabled := false
+	if enabledPair := lookup(modPair.value, "enabled"); enabledPair != nil {
+		enabled, _ = strconv.ParseBool(enabledPair.value.Value)
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	return &modulePosition{
+		line:     positionPair.key.Line,
+		name:     modPair.key.Value,
+		settings: common.PositionSettings,
+	}
+}
+
+// checkPositionKeys validates a position's top, left, width and height, and returns
+// whether it's valid
+func checkPositionKeys(probs *problems, positionPath string, positionPair yamlPair, settings cfg.PositionSettings) bool {
+	valid := true
+
+	for _, pair := range pairs(positionPair.value) {
+		if !contains(positionKeys, pair.key.Value) {
+			probs.error(pair.key.Line, positionPath+"."+pair.key.Value, "unknown key %q, expected one of %s", pair.key.Value, strings.Join(positionKeys, ", "))
+		}
+	}
+
+	for _, key := range positionKeys {
+		pair := lookup(positionPair.value, key)
+
+		switch {
+		case pair == nil:
+			probs.error(positionPair.key.Line, positionPath, "missing required key %q", key)
+			valid = false
+		case !isInt(pair.value):
+			probs.error(pair.value.Line, positionPath+"."+key, "expected a number, found %s", kindName(pair.value))
+			valid = false
+		}
+	}
+
+	if !valid || settings.Validations == nil {
+		return valid
+	}
+
+	for _, val := range settings.Validations.All() {
+		if val.HasError() {
+			probs.error(positionPair.key.Line, positionPath, "%v", val.Error())
+			valid = false
+		}
+	}
+
+	return valid
+}
+
+// checkDuration validates a refresh interval, or any other duration, the way
+// cfg.ParseTimeString reads it: either a whole number of seconds or a Go duration string
+func checkDuration(probs *problems, path string, value *yaml.Node) {
+	if !isScalar(value) {
+		probs.error(value.Line, path, "expected a number of seconds or a duration such as \"5m\", found %s", kindName(value))
+		return
+	}
+
+	if secs, err := strconv.Atoi(value.Value); err == nil {
+		if secs < 0 {
+			probs.error(value.Line, path, "the duration can't be negative")
+		}
+		return
+	}
+
+	duration, err := time.ParseDuration(value.Value)
+	if err != nil {
+		probs.error(value.Line, path, "%q is not a valid duration, it will be treated as 1s", value.Value)
+		return
+	}
+
+	if duration < 0 {
+		probs.error(value.Line, path, "the duration can't be negative")
+	}
+}
+
+// checkType validates that a value can be read into a settings field of the given type
+func checkType(probs *problems, path string, value *yaml.Node, fieldType reflect.Type) {
+	if isNull(value) {
+		return
+	}
+
+	// Durations are read with cfg.ParseTimeString
+	if fieldType == reflect.TypeOf(time.Duration(0)) {
+		checkDuration(probs, path, value)
+		return
+	}
+
+	expected := ""
+
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		if !isBool(value) {
+			expected = "true or false"
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !isInt(value) {
+			expected = "a whole number"
+		}
+	case reflect.Float32, reflect.Float64:
+		if !isFloat(value) {
+			expected = "a number"
+		}
+	case reflect.String:
+		if !isScalar(value) {
+			expected = "a string"
+		}
+	case reflect.Slice, reflect.Array:
+		// Some modules build their lists from maps, so both are acceptable
+		if value.Kind != yaml.SequenceNode && value.Kind != yaml.MappingNode {
+			expected = "a list"
+		}
+	case reflect.Map, reflect.Struct:
+		if value.Kind != yaml.MappingNode {
+			expected = "a map"
+		}
+	}
+
+	if expected != "" {
+		probs.error(value.Line, path, "expected %s, found %s", expected, kindName(value))
+	}
+}
+
+func isUnknownModule(settings reflect.Value) bool {
+	return strings.HasSuffix(settings.Type().PkgPath(), "/modules/unknown")
+}
+
+// lookupFold returns the pair for the given key in a mapping, ignoring case
+func lookupFold(node *yaml.Node, key string) *yamlPair {
+	for _, pair := range pairs(node) {
+		if strings.EqualFold(pair.key.Value, key) {
+			found := pair
+			return &found
+		}
+	}
+
+	return nil
+}
+
+// makeSettings creates the module's settings without creating its widget, as they
+// would be read when the app runs. The secrets they refer to aren't fetched, validating
+// doesn't run the secret providers, so only the secrets set in the config are filled in
+func makeSettings(globalConfig *config.Config, name string) (settings reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	var made interface{}
+	cfg.RecordSecretReferences(func() {
+		made = app.MakeSettings(name, globalConfig)
+	})
+
+	value := reflect.ValueOf(made)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("no settings were created")
+	}
+
+	return value, nil
+}
+
+// commonOf returns the settings common to all modules from a module's settings struct
+func commonOf(settings reflect.Value) *cfg.Common {
+	common := settings.FieldByName("Common")
+	if !common.IsValid() || !common.CanInterface() {
+		return nil
+	}
+
+	result, _ := common.Interface().(*cfg.Common)
+	return result
+}
+
+// settingsFields returns the module-specific fields of a settings struct. The fields
+// common to all modules are handled separately
+func settingsFields(settings reflect.Value) []settingsField {
+	fields := []settingsField{}
+	settingsType := settings.Type()
+
+	for i := 0; i < settingsType.NumField(); i++ {
+		field := settingsType.Field(i)
+
+		if field.Anonymous && field.Type == reflect.TypeOf(&cfg.Common{}) {
+			continue
+		}
+
+		fields = append(fields, settingsField{
+			key:   utils.LowercaseTitle(field.Name),
+			field: field,
+			value: settings.Field(i),
+		})
+	}
+
+	return fields
+}
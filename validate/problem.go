@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Severity describes how serious a configuration problem is
+type Severity int
+
+const (
+	// SeverityError is used for problems that break the module or the layout
+	SeverityError Severity = iota
+
+	// SeverityWarning is used for problems that may be harmless, such as keys
+	// that validate doesn't recognize
+	SeverityWarning
+)
+
+// String returns the name of the severity
+func (severity Severity) String() string {
+	if severity == SeverityWarning {
+		return "warning"
+	}
+
+	return "error"
+}
+
+// Problem is a single issue found in a configuration file
+type Problem struct {
+	// File is the included file the problem is in, or empty if it's in the config file itself
+	File     string
+	Line     int
+	Message  string
+	Path     string
+	Severity Severity
+}
+
+// String returns the problem in the form `line: severity: path: message`, preceded by the
+// file if it's in an included file
+func (problem Problem) String() string {
+	if problem.File != "" {
+		return fmt.Sprintf("%s:%d: %s: %s: %s", problem.File, problem.Line, problem.Severity, problem.Path, problem.Message)
+	}
+
+	return fmt.Sprintf("%d: %s: %s: %s", problem.Line, problem.Severity, problem.Path, problem.Message)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+type problems []Problem
+
+func (probs *problems) add(severity Severity, line int, path, format string, args ...interface{}) {
+	*probs = append(*probs, Problem{
+		Line:     line,
+		Message:  fmt.Sprintf(format, args...),
+		Path:     path,
+		Severity: severity,
+	})
+}
+
+func (probs *problems) error(line int, path, format string, args ...interface{}) {
+	probs.add(SeverityError, line, path, format, args...)
+}
+
+func (probs *problems) warning(line int, path, format string, args ...interface{}) {
+	probs.add(SeverityWarning, line, path, format, args...)
+}
+
+func (probs problems) sorted() []Problem {
+	sorted := append([]Problem{}, probs...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Line != sorted[j].Line {
+			return sorted[i].Line < sorted[j].Line
+		}
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	return sorted
+}
@@ -0,0 +1,83 @@
+package validate
+
+import "strings"
+
+// maxSuggestionDistance is the most edits a key can be from a known key for that known
+// key to be suggested as what was meant
+const maxSuggestionDistance = 2
+
+/* -------------------- Unexported Functions -------------------- */
+
+func contains(items []string, item string) bool {
+	for _, candidate := range items {
+		if candidate == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggest returns the known key closest to the given one, or an empty string if none
+// of them are close enough to be a likely misspelling
+func suggest(key string, known []string) string {
+	best := ""
+	bestDistance := maxSuggestionDistance + 1
+
+	for _, candidate := range known {
+		distance := editDistance(strings.ToLower(key), strings.ToLower(candidate))
+
+		// Short keys are too easily confused with one another to guess between
+		if distance >= len(candidate)/2 {
+			continue
+		}
+
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+
+	prev := make([]int, len(runesB)+1)
+	curr := make([]int, len(runesB)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(runesA); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(runesB); j++ {
+			cost := 1
+			if runesA[i-1] == runesB[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(runesB)]
+}
+
+func min3(a, b, c int) int {
+	result := a
+	if b < result {
+		result = b
+	}
+	if c < result {
+		result = c
+	}
+
+	return result
+}
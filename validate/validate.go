@@ -0,0 +1,268 @@
+// Package validate checks a configuration file offline, without running any widgets.
+//
+// The checks are driven by the `help`, `values`, `optional` and `default` struct tags on
+// each module's settings, which are the same tags that `wtfutil --module` reads to display
+// a module's configuration help.
+package validate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/httpclient"
+	"github.com/wtfutil/wtf/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// ignoredGlobalKeys are keys that are common in config files but have no effect
+var ignoredGlobalKeys = []string{
+	"refreshInterval",
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Display validates the config file and writes any problems found to the console.
+// It returns the exit code for the process: 0 if there were no errors, 1 otherwise
+func Display(filePath string) int {
+	probs, err := Validate(filePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s %v\n", aurora.Red("ERROR"), err)
+		return 1
+	}
+
+	errCount := 0
+
+	for _, problem := range probs {
+		fileName := filepath.Base(filePath)
+		if problem.File != "" {
+			fileName = relativePath(filepath.Dir(filePath), problem.File)
+		}
+
+		severity := aurora.Yellow(problem.Severity.String())
+		if problem.Severity == SeverityError {
+			severity = aurora.Red(problem.Severity.String())
+			errCount++
+		}
+
+		fmt.Printf("%s:%d: %s: %s: %s\n", fileName, problem.Line, severity, aurora.Bold(problem.Path), problem.Message)
+	}
+
+	if len(probs) == 0 {
+		fmt.Printf("%s %s is valid\n", aurora.Green("OK"), filePath)
+		return 0
+	}
+
+	fmt.Printf("\n%d error(s), %d warning(s) in %s\n", errCount, len(probs)-errCount, filePath)
+
+	if errCount > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// Validate checks the config file at the given path, along with the files it includes and
+// the environment overlay selected with --env or WTF_ENV, and returns the problems found
+// in them, ordered by file and line number. An error is returned if a file can't be read
+// or isn't valid YAML
+func Validate(filePath string) ([]Problem, error) {
+	fragments, err := cfg.LoadConfigFragments(filePath, cfg.Environment())
+	if err != nil {
+		return nil, err
+	}
+
+	return validateFragments(fragments, filePath, cfg.Environment())
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// lineBase separates the line numbers of the files a config is merged from. The nodes of
+// each file are renumbered to start at a multiple of it, so that the checks, which only
+// deal in line numbers, can be run once over the merged config and each problem can still
+// be traced back to its file
+const lineBase = 1 << 20
+
+func validateYAML(data []byte) ([]Problem, error) {
+	parsed, err := config.ParseYamlBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	root, _ := parsed.Root.(map[string]interface{})
+
+	return validateFragments([]cfg.ConfigFragment{{Data: data, Root: root}}, "", "")
+}
+
+// validateFragments merges the YAML of the files a config is loaded from, the same way
+// cfg.MergeConfigFragments merges their values, and checks the result. filePath is the
+// config file the others are included by, whose problems aren't prefixed with its name
+func validateFragments(fragments []cfg.ConfigFragment, filePath, environment string) ([]Problem, error) {
+	// The config file is merged after the files it includes, but keeps the first place
+	files := []string{filePath}
+	roots := map[string]*yaml.Node{}
+
+	var merged *yaml.Node
+
+	for _, fragment := range fragments {
+		// Inline environment overlays are part of the file that defines them
+		if len(fragment.Data) == 0 {
+			merged = mergeNodes(merged, overlayNode(roots[fragment.Path], environment))
+			continue
+		}
+
+		root := &yaml.Node{}
+		if err := yaml.Unmarshal(fragment.Data, root); err != nil {
+			return nil, err
+		}
+
+		file := 0
+		if fragment.Path != filePath {
+			file = len(files)
+			files = append(files, fragment.Path)
+		}
+
+		renumberLines(root, file*lineBase, fragment.Offset)
+		roots[fragment.Path] = root
+
+		merged = mergeNodes(merged, root)
+	}
+
+	globalConfig := &config.Config{Root: cfg.MergeConfigFragments(fragments)}
+
+	dir := ""
+	if filePath != "" {
+		dir = filepath.Dir(filePath)
+	}
+
+	probs := checkConfig(merged, globalConfig, dir)
+
+	for idx, problem := range probs {
+		file := problem.Line / lineBase
+		if file > 0 && file < len(files) {
+			probs[idx].File = files[file]
+		}
+		probs[idx].Line = problem.Line % lineBase
+	}
+
+	return probs, nil
+}
+
+// checkConfig checks the merged config. dir is the directory of the config file, which
+// relative paths in it are resolved against
+func checkConfig(root *yaml.Node, globalConfig *config.Config, dir string) []Problem {
+	probs := problems{}
+
+	wtfPair := lookup(root, "wtf")
+	if wtfPair == nil {
+		probs.error(1, "wtf", "the config file has no `wtf` section")
+		return probs.sorted()
+	}
+
+	checkGlobals(&probs, wtfPair.value)
+	checkTheme(&probs, wtfPair.value, dir)
+	checkAlerts(&probs, globalConfig, wtfPair.value)
+	checkHTTP(&probs, globalConfig, wtfPair.value)
+	globalKeys := checkGlobalKeyBindings(&probs, wtfPair.value)
+
+	modsPair := lookup(wtfPair.value, "mods")
+	if modsPair == nil {
+		probs.error(wtfPair.key.Line, "wtf.mods", "no modules are defined")
+		return probs.sorted()
+	}
+
+	positions := []modulePosition{}
+
+	for _, modPair := range pairs(modsPair.value) {
+		position := checkModule(&probs, globalConfig, modPair, globalKeys)
+		if position != nil {
+			positions = append(positions, *position)
+		}
+	}
+
+	checkOverlaps(&probs, positions)
+
+	return probs.sorted()
+}
+
+func checkGlobals(probs *problems, wtfNode *yaml.Node) {
+	knownGlobalKeys := schema.GlobalKeys()
+
+	for _, pair := range pairs(wtfNode) {
+		if contains(knownGlobalKeys, pair.key.Value) {
+			continue
+		}
+
+		if contains(ignoredGlobalKeys, pair.key.Value) {
+			probs.warning(pair.key.Line, "wtf."+pair.key.Value, "%q has no effect here, set it on each module instead", pair.key.Value)
+			continue
+		}
+
+		message := fmt.Sprintf("unknown key %q", pair.key.Value)
+		if suggestion := suggest(pair.key.Value, knownGlobalKeys); suggestion != "" {
+			message += fmt.Sprintf(", did you mean %q?", suggestion)
+		}
+
+		probs.error(pair.key.Line, "wtf."+pair.key.Value, "%s", message)
+	}
+}
+
+// checkAlerts checks that each of the rules in `wtf.alerts` can be read
+func checkAlerts(probs *problems, globalConfig *config.Config, wtfNode *yaml.Node) {
+	alertsPair := lookup(wtfNode, "alerts")
+	if alertsPair == nil {
+		return
+	}
+
+	alerts := resolve(alertsPair.value)
+	if alerts.Kind != yaml.SequenceNode {
+		probs.error(alertsPair.key.Line, "wtf.alerts", "alerts should be a list of rules")
+		return
+	}
+
+	for idx, item := range alerts.Content {
+		path := fmt.Sprintf("wtf.alerts.%d", idx)
+		if _, err := cfg.NewAlertRule(globalConfig, path); err != nil {
+			probs.error(item.Line, path, "%s", err)
+		}
+	}
+}
+
+// checkHTTP checks that the proxy, the certificates and the host patterns in `wtf.http`
+// can be used
+func checkHTTP(probs *problems, globalConfig *config.Config, wtfNode *yaml.Node) {
+	httpPair := lookup(wtfNode, "http")
+	if httpPair == nil {
+		return
+	}
+
+	if err := httpclient.Check(cfg.NewHTTPOptions(globalConfig)); err != nil {
+		probs.error(httpPair.key.Line, "wtf.http", "%s", err)
+	}
+}
+
+// checkTheme checks that the theme named in `wtf.theme` exists and can be read
+func checkTheme(probs *problems, wtfNode *yaml.Node, dir string) {
+	themePair := lookup(wtfNode, "theme")
+	if themePair == nil || themePair.value.Kind != yaml.ScalarNode || themePair.value.Value == "" {
+		return
+	}
+
+	if _, err := cfg.LoadTheme(themePair.value.Value, dir); err != nil {
+		probs.error(themePair.value.Line, "wtf.theme", "%s", err)
+	}
+}
+
+// relativePath returns path relative to dir if it's inside it, otherwise path itself
+func relativePath(dir, path string) string {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return rel
+}
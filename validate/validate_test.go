@@ -0,0 +1,309 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+	_ "github.com/wtfutil/wtf/modules/all"
+	"github.com/wtfutil/wtf/schema"
+)
+
+const testConfig = `wtf:
+  colours: {}
+  mods:
+    clocks:
+      enabled: true
+      locations:
+        Toronto: "America/Toronto"
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 2
+      refreshInteval: 15
+      sort: 3
+    reddit:
+      enabled: true
+      position:
+        top: 0
+        left: 1
+        height: 1
+        width: 1
+      refreshInterval: "5 minutes"
+      type: subreddit
+    mystery:
+      type: notamodule
+      position:
+        top: 1
+        left: 0
+        height: 1
+        width: 1
+`
+
+func Test_validateYAML(t *testing.T) {
+	probs, err := validateYAML([]byte(testConfig))
+	assert.NoError(t, err)
+
+	actual := []string{}
+	for _, problem := range probs {
+		actual = append(actual, problem.String())
+	}
+
+	expected := []string{
+		`2: error: wtf.colours: unknown key "colours", did you mean "colors"?`,
+		`13: error: wtf.mods.clocks.refreshInteval: unknown key "refreshInteval", did you mean "refreshInterval"?`,
+		`15: error: wtf.mods.reddit: missing required key "subreddit": Subreddit to look at`,
+		`17: error: wtf.mods.reddit.position: overlaps the position of "clocks"`,
+		`22: error: wtf.mods.reddit.refreshInterval: "5 minutes" is not a valid duration, it will be treated as 1s`,
+		`25: error: wtf.mods.mystery.type: unknown module type "notamodule"`,
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_validateYAML_Anchors(t *testing.T) {
+	config := `defaults: &defaults
+  enabled: true
+  refreshInterval: 30
+wtf:
+  mods:
+    clocks:
+      <<: *defaults
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+`
+
+	probs, err := validateYAML([]byte(config))
+	assert.NoError(t, err)
+
+	for _, problem := range probs {
+		assert.Equal(t, SeverityWarning, problem.Severity, problem.String())
+	}
+}
+
+func Test_checkType(t *testing.T) {
+	probs, err := validateYAML([]byte(`wtf:
+  mods:
+    clocks:
+      dateFormat: [1, 2]
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(probs))
+	assert.Equal(t, `4: error: wtf.mods.clocks.dateFormat: expected a string, found a list`, probs[0].String())
+}
+
+func Test_validateYAML_Layouts(t *testing.T) {
+	probs, err := validateYAML([]byte(`wtf:
+  grid:
+    columns: [40, 40]
+    rows: [10, 10]
+  layouts:
+    - name: laptop
+      maxWidth: 159
+      columns: [40]
+      rows: [10, 10]
+  mods:
+    clocks:
+      layouts:
+        laptop: {top: 0, left: 0, height: 1, width: x}
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+    clocks_b:
+      type: clocks
+      layouts:
+        laptop: {top: 1, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	actual := []string{}
+	for _, problem := range probs {
+		actual = append(actual, problem.String())
+	}
+
+	expected := []string{
+		`13: error: wtf.mods.clocks.layouts.laptop.width: expected a number, found a string`,
+		`16: warning: wtf.mods.clocks_b: missing key "position", the module isn't displayed in the grid`,
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_validateYAML_Theme(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	probs, err := validateYAML([]byte(`wtf:
+  theme: solarised-dark
+  mods:
+    clocks:
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(probs))
+	assert.Equal(t, `2: error: wtf.theme: unknown theme "solarised-dark", expected a path or one of dark, gruvbox-dark, light, solarized-dark, solarized-light`, probs[0].String())
+}
+
+func Test_validateYAML_Alerts(t *testing.T) {
+	probs, err := validateYAML([]byte(`wtf:
+  alerts:
+    - modules: jenkins
+      notify: bell
+    - name: sites down
+      notify: [desktop, pager]
+  mods:
+    clocks:
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(probs))
+	assert.Equal(t, `5: error: wtf.alerts.1: unknown notify channel "pager", expected one of [bell desktop osc9 osc777 webhook]`, probs[0].String())
+}
+
+func Test_validateYAML_HTTP(t *testing.T) {
+	probs, err := validateYAML([]byte(`wtf:
+  http:
+    proxy: proxy.corp:3128
+    hosts:
+      - host: api.github.com
+        rateLimit: 1
+  mods:
+    clocks:
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(probs))
+	assert.Contains(t, probs[0].String(), `2: error: wtf.http: proxy "proxy.corp:3128" is invalid`)
+}
+
+func Test_suggest(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+	}{
+		{name: "misspelled", key: "refreshIntreval", expected: "refreshInterval"},
+		{name: "wrong case", key: "focuschar", expected: "focusChar"},
+		{name: "too different", key: "location", expected: ""},
+		{name: "short", key: "tpe", expected: "type"},
+		{name: "too short to guess", key: "x", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, suggest(tt.key, schema.CommonModuleKeys()))
+		})
+	}
+}
+
+func Test_validateYAML_Keys(t *testing.T) {
+	probs, err := validateYAML([]byte(`wtf:
+  keys:
+    quit: x
+    next-widget: Esc
+    select-next-item: [j, Down]
+    select-previous-item: j
+    clear-selection: Hyper-X
+  mods:
+    clocks:
+      keys:
+        refresh: Ctrl-R
+        open-item: Down
+        docs: ""
+        new-item: n
+      position: {top: 0, left: 0, height: 1, width: 1}
+      sort: 3
+`))
+	assert.NoError(t, err)
+
+	actual := []string{}
+	for _, problem := range probs {
+		actual = append(actual, problem.String())
+	}
+
+	expected := []string{
+		`4: error: wtf.keys.next-widget: Esc is already bound to "unfocus"`,
+		`6: error: wtf.keys.select-previous-item: j is already bound to "select-next-item"`,
+		`7: error: wtf.keys.clear-selection: unknown key: Hyper-X`,
+		`11: error: wtf.mods.clocks.keys.refresh: Ctrl-R is used by the app-wide "refresh-all" action, so the widget never sees it`,
+		`12: error: wtf.mods.clocks.keys.open-item: Down is already bound to "select-next-item"`,
+		`14: error: wtf.mods.clocks.keys.new-item: n is read as true or false, write it as "n"`,
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Validate_Includes(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"config.yml": `include: mods.d/*.yml
+environments:
+  work:
+    wtf:
+      mods:
+        clocks:
+          refreshInteval: 5
+wtf:
+  mods:
+    clocks:
+      enabled: true
+      position: {top: 0, left: 0, height: 1, width: 1}
+`,
+		"mods.d/github.yml": `wtf:
+  mods:
+    clocks:
+      sort: alphabetical
+    github:
+      enabled: true
+      position: {top: 0, left: 0, height: 1, width: 1}
+      repositorys: []
+`,
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	t.Setenv(cfg.EnvironmentVar, "work")
+
+	// Validating doesn't ask the secret providers, so the apiKey is still reported as missing
+	t.Setenv("WTF_SECRET_GITHUB", "abc")
+
+	probs, err := Validate(filepath.Join(dir, "config.yml"))
+	assert.NoError(t, err)
+
+	actual := []string{}
+	for _, problem := range probs {
+		if problem.File != "" {
+			problem.File = filepath.ToSlash(relativePath(dir, problem.File))
+		}
+		actual = append(actual, problem.String())
+	}
+
+	expected := []string{
+		`7: error: wtf.mods.clocks.refreshInteval: unknown key "refreshInteval", did you mean "refreshInterval"?`,
+		`mods.d/github.yml:5: warning: wtf.mods.github: missing key "apiKey", which isn't marked as optional: Your GitHub API token.`,
+		`mods.d/github.yml:5: warning: wtf.mods.github: missing key "username", which isn't marked as optional: Your GitHub username. Used to figure out which review requests you’ve been added to.`,
+		`mods.d/github.yml:7: error: wtf.mods.github.position: overlaps the position of "clocks"`,
+		`mods.d/github.yml:8: warning: wtf.mods.github.repositorys: unknown key "repositorys", did you mean "repositories"?`,
+	}
+
+	assert.Equal(t, expected, actual)
+}
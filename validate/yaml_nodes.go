@@ -0,0 +1,226 @@
+package validate
+
+import (
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlPair is a key and its value in a YAML mapping
+type yamlPair struct {
+	key   *yaml.Node
+	value *yaml.Node
+}
+
+const mergeKey = "<<"
+
+/* -------------------- Unexported Functions -------------------- */
+
+// resolve follows aliases and unwraps documents so that the node describes a value
+func resolve(node *yaml.Node) *yaml.Node {
+	for node != nil {
+		switch node.Kind {
+		case yaml.AliasNode:
+			node = node.Alias
+		case yaml.DocumentNode:
+			if len(node.Content) == 0 {
+				return nil
+			}
+			node = node.Content[0]
+		default:
+			return node
+		}
+	}
+
+	return nil
+}
+
+// pairs returns the key/value pairs of a mapping in the order they appear, including
+// any merged in with `<<`. Keys defined in the mapping itself win over merged ones
+func pairs(node *yaml.Node) []yamlPair {
+	node = resolve(node)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	result := []yamlPair{}
+	seen := map[string]bool{}
+	merged := []yamlPair{}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		if key.Value == mergeKey {
+			merged = append(merged, mergedPairs(value)...)
+			continue
+		}
+
+		seen[key.Value] = true
+		result = append(result, yamlPair{key: key, value: resolve(value)})
+	}
+
+	for _, pair := range merged {
+		if !seen[pair.key.Value] {
+			seen[pair.key.Value] = true
+			result = append(result, pair)
+		}
+	}
+
+	return result
+}
+
+func mergedPairs(node *yaml.Node) []yamlPair {
+	node = resolve(node)
+	if node == nil {
+		return nil
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		return pairs(node)
+	}
+
+	result := []yamlPair{}
+	for _, item := range node.Content {
+		result = append(result, pairs(item)...)
+	}
+
+	return result
+}
+
+// lookup returns the pair for the given key in a mapping, or nil if there isn't one
+func lookup(node *yaml.Node, key string) *yamlPair {
+	for _, pair := range pairs(node) {
+		if pair.key.Value == key {
+			found := pair
+			return &found
+		}
+	}
+
+	return nil
+}
+
+func isBool(node *yaml.Node) bool {
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+
+	_, err := strconv.ParseBool(node.Value)
+	return err == nil
+}
+
+func isFloat(node *yaml.Node) bool {
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(node.Value, 64)
+	return err == nil
+}
+
+func isInt(node *yaml.Node) bool {
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+
+	if _, err := strconv.Atoi(node.Value); err == nil {
+		return true
+	}
+
+	// The config library turns whole-number floats into ints
+	f, err := strconv.ParseFloat(node.Value, 64)
+	return err == nil && f == float64(int(f))
+}
+
+func isNull(node *yaml.Node) bool {
+	return node == nil || (node.Kind == yaml.ScalarNode && node.Tag == "!!null")
+}
+
+func isScalar(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && !isNull(node)
+}
+
+// kindName describes a node's type for error messages
+func kindName(node *yaml.Node) string {
+	switch {
+	case isNull(node):
+		return "nothing"
+	case node.Kind == yaml.MappingNode:
+		return "a map"
+	case node.Kind == yaml.SequenceNode:
+		return "a list"
+	case node.Tag == "!!bool":
+		return "a boolean"
+	case node.Tag == "!!int", node.Tag == "!!float":
+		return "a number"
+	}
+
+	return "a string"
+}
+
+// mergeNodes merges the src mapping over dst, key by key, the same way the values of
+// config files are merged. Anything that isn't a mapping replaces what was there
+func mergeNodes(dst, src *yaml.Node) *yaml.Node {
+	dst, src = resolve(dst), resolve(src)
+
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil || dst.Kind != yaml.MappingNode || src.Kind != yaml.MappingNode {
+		return src
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: dst.Tag, Line: dst.Line, Column: dst.Column}
+	indexes := map[string]int{}
+
+	for _, pair := range pairs(dst) {
+		indexes[pair.key.Value] = len(merged.Content)
+		merged.Content = append(merged.Content, pair.key, pair.value)
+	}
+
+	for _, pair := range pairs(src) {
+		if idx, ok := indexes[pair.key.Value]; ok {
+			merged.Content[idx+1] = mergeNodes(merged.Content[idx+1], pair.value)
+			continue
+		}
+
+		indexes[pair.key.Value] = len(merged.Content)
+		merged.Content = append(merged.Content, pair.key, pair.value)
+	}
+
+	return merged
+}
+
+// overlayNode returns the inline overlay for the environment in a config file
+func overlayNode(root *yaml.Node, environment string) *yaml.Node {
+	environmentsPair := lookup(root, "environments")
+	if environmentsPair == nil {
+		return nil
+	}
+
+	overlayPair := lookup(environmentsPair.value, environment)
+	if overlayPair == nil {
+		return nil
+	}
+
+	return overlayPair.value
+}
+
+// renumberLines moves the line numbers of a file's nodes to start at base. The first
+// offset lines hold the anchors of the files loaded before it, which are numbered as
+// the file's first line
+func renumberLines(node *yaml.Node, base, offset int) {
+	if node == nil {
+		return
+	}
+
+	line := node.Line - offset
+	if line < 1 {
+		line = 1
+	}
+	node.Line = base + line
+
+	for _, child := range node.Content {
+		renumberLines(child, base, offset)
+	}
+}
@@ -50,7 +50,14 @@ func NewBarGraph(tviewApp *tview.Application, redrawChan chan bool, _ string, co
 // time should be passed as a int64
 func (widget *BarGraph) BuildBars(data []Bar) {
 	widget.View.SetText(BuildStars(data, widget.maxStars, widget.starChar))
-	widget.Base.RedrawChan <- true
+
+	widget.markRefreshed()
+
+	// A redraw that's already pending draws the new bars too, so there's no need to wait
+	select {
+	case widget.Base.RedrawChan <- true:
+	default:
+	}
 }
 
 // BuildStars build the string to display
@@ -2,26 +2,43 @@ package view
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cache"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/notify"
+	"github.com/wtfutil/wtf/state"
 	"github.com/wtfutil/wtf/utils"
 )
 
+const errorSigil = "✘"
+
 type Base struct {
 	bordered        bool
+	cachedAt        time.Time
 	commonSettings  *cfg.Common
+	failures        int
+	lastSuccessAt   time.Time
+	logger          *logger.Logger
 	enabled         bool
 	enabledMutex    *sync.Mutex
 	focusChar       string
 	focusable       bool
 	helpTextFunc    func() string
 	name            string
+	paused          bool
 	pages           *tview.Pages
 	quitChan        chan bool
 	refreshInterval time.Duration
+	refreshErr      error
+	refreshMutex    *sync.Mutex
+	refreshedAt     time.Time
 	refreshing      bool
 	tviewApp        *tview.Application
 	view            *tview.TextView
@@ -40,10 +57,12 @@ func NewBase(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pag
 		enabledMutex:    &sync.Mutex{},
 		focusChar:       commonSettings.FocusChar(),
 		focusable:       commonSettings.Focusable,
+		logger:          logger.Module(commonSettings.Name),
 		name:            commonSettings.Name,
 		pages:           pages,
 		quitChan:        make(chan bool),
 		refreshInterval: commonSettings.RefreshInterval,
+		refreshMutex:    &sync.Mutex{},
 		refreshing:      false,
 		tviewApp:        tviewApp,
 
@@ -60,8 +79,13 @@ func (base *Base) Bordered() bool {
 	return base.bordered
 }
 
-// BorderColor returns the color that the border of this widget should be drawn in
+// BorderColor returns the color that the border of this widget should be drawn in.
+// Widgets whose most recent refresh failed are drawn in the error color
 func (base *Base) BorderColor() string {
+	if base.RefreshError() != nil && base.commonSettings.Colors.BorderTheme.Error != "" {
+		return base.commonSettings.Colors.BorderTheme.Error
+	}
+
 	if base.Focusable() {
 		return base.commonSettings.Colors.BorderTheme.Focusable
 	}
@@ -77,7 +101,28 @@ func (base *Base) ConfigText() string {
 	return utils.HelpFromInterface(cfg.Common{})
 }
 
+// CachedAt returns when the data the widget is displaying was cached, if it's displaying
+// cached data because it hasn't refreshed successfully since it was loaded. It returns
+// the zero time otherwise
+func (base *Base) CachedAt() time.Time {
+	base.refreshMutex.Lock()
+	result := base.cachedAt
+	base.refreshMutex.Unlock()
+	return result
+}
+
+// ContextualTitle returns the title to display for the widget, decorated with its focus
+// character, an error badge if its most recent refresh failed, and the age of its data
+// if it's displaying cached data
 func (base *Base) ContextualTitle(defaultStr string) string {
+	if base.RefreshError() != nil {
+		defaultStr = strings.TrimSpace(base.errorBadge() + " " + defaultStr)
+	}
+
+	if cachedAt := base.CachedAt(); !cachedAt.IsZero() {
+		defaultStr = strings.TrimSpace(defaultStr + " " + base.staleBadge(cachedAt))
+	}
+
 	switch {
 	case defaultStr == "" && base.FocusChar() == "":
 		return ""
@@ -90,6 +135,14 @@ func (base *Base) ContextualTitle(defaultStr string) string {
 	return fmt.Sprintf(" %s [darkgray::u]%s[::-][white] ", defaultStr, base.FocusChar())
 }
 
+// ConsecutiveFailures returns how many refreshes in a row have reported an error
+func (base *Base) ConsecutiveFailures() int {
+	base.refreshMutex.Lock()
+	result := base.failures
+	base.refreshMutex.Unlock()
+	return result
+}
+
 func (base *Base) Disable() {
 	base.enabledMutex.Lock()
 	base.enabled = false
@@ -125,24 +178,156 @@ func (base *Base) Name() string {
 	return base.name
 }
 
+// LastSuccessAt returns the time of the widget's most recent successful refresh, or the
+// zero time if it has never reported one
+func (base *Base) LastSuccessAt() time.Time {
+	base.refreshMutex.Lock()
+	result := base.lastSuccessAt
+	base.refreshMutex.Unlock()
+	return result
+}
+
+// LoadCache reads the data the module last saved with SaveCache into data, and marks the
+// widget as displaying cached data until its next successful refresh. It returns FALSE
+// if caching is turned off for the module or nothing has been cached
+func (base *Base) LoadCache(data interface{}) bool {
+	if !base.commonSettings.Cache {
+		return false
+	}
+
+	savedAt, err := cache.Load(base.cacheKey(), data)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			base.logger.Warnf("Reading the cached data failed: %s", err)
+		}
+		return false
+	}
+
+	base.refreshMutex.Lock()
+	base.cachedAt = savedAt
+	base.refreshMutex.Unlock()
+
+	return true
+}
+
+// LoadState reads the value of the widget's UI state SaveState remembered under key into
+// value. It returns FALSE, leaving value alone, if there's none
+func (base *Base) LoadState(key string, value interface{}) bool {
+	return state.Load(moduleKey(base.commonSettings), key, value)
+}
+
+// Logger returns the logger whose entries are tagged with this widget's name
+func (base *Base) Logger() *logger.Logger {
+	return base.logger
+}
+
+// Pause stops the scheduler from refreshing this widget's data until Resume is called
+func (base *Base) Pause() {
+	base.enabledMutex.Lock()
+	base.paused = true
+	base.enabledMutex.Unlock()
+}
+
+// Paused returns TRUE if the widget's scheduled refreshes are currently suspended
+func (base *Base) Paused() bool {
+	base.enabledMutex.Lock()
+	result := base.paused
+	base.enabledMutex.Unlock()
+	return result
+}
+
 func (base *Base) QuitChan() chan bool {
 	return base.quitChan
 }
 
+// RaiseEvents reports the events the module currently has, for the alert rules to
+// deliver. Modules call it with all of them each time they refresh successfully, see
+// notify.Raise
+func (base *Base) RaiseEvents(events []notify.Event) {
+	notify.Raise(base.name, base.commonSettings.Type, events)
+}
+
+// RefreshedAt returns the time at which the widget last displayed new data. It returns
+// the zero time if the widget has not displayed any data yet
+func (base *Base) RefreshedAt() time.Time {
+	base.refreshMutex.Lock()
+	result := base.refreshedAt
+	base.refreshMutex.Unlock()
+	return result
+}
+
 // Refreshing returns TRUE if the base is currently refreshing its data, FALSE if it is not
 func (base *Base) Refreshing() bool {
 	return base.refreshing
 }
 
+// RefreshError returns the error reported by the most recent refresh, or nil if it succeeded
+func (base *Base) RefreshError() error {
+	base.refreshMutex.Lock()
+	result := base.refreshErr
+	base.refreshMutex.Unlock()
+	return result
+}
+
 // RefreshInterval returns how often the base will return its data
 func (base *Base) RefreshInterval() time.Duration {
 	return base.refreshInterval
 }
 
+// Resume allows the scheduler to refresh this widget's data again after a call to Pause
+func (base *Base) Resume() {
+	base.enabledMutex.Lock()
+	base.paused = false
+	base.enabledMutex.Unlock()
+}
+
+// SaveCache stores the data the module fetched, for LoadCache to read the next time WTF
+// starts or the data can't be fetched. Modules call it after each successful refresh
+func (base *Base) SaveCache(data interface{}) {
+	if !base.commonSettings.Cache {
+		return
+	}
+
+	if err := cache.Save(base.cacheKey(), data); err != nil {
+		base.logger.Warnf("Caching the data failed: %s", err)
+	}
+}
+
+// SaveState remembers a value of the widget's UI state, such as a toggle, under key so
+// that LoadState can restore it after a restart
+func (base *Base) SaveState(key string, value interface{}) {
+	state.Save(moduleKey(base.commonSettings), key, value)
+}
+
+// SetRefreshError records the outcome of the widget's most recent data refresh. Modules
+// call this from Refresh() with the error they encountered, or nil on success, so that
+// the scheduler can back off from failing data sources
+func (base *Base) SetRefreshError(err error) {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	base.refreshErr = err
+
+	if err == nil {
+		base.cachedAt = time.Time{}
+		base.failures = 0
+		base.lastSuccessAt = time.Now()
+		return
+	}
+
+	base.failures++
+}
+
 func (base *Base) SetFocusChar(char string) {
 	base.focusChar = char
 }
 
+// SetHelpTextFunc replaces the function that provides the text for the help dialog, for
+// modules whose help text doesn't come from their keyboard commands alone
+func (base *Base) SetHelpTextFunc(helpTextFunc func() string) {
+	base.helpTextFunc = helpTextFunc
+}
+
 // SetView assigns the passed-in tview.TextView view to this widget
 func (base *Base) SetView(view *tview.TextView) {
 	base.view = view
@@ -168,13 +353,62 @@ func (base *Base) ShowHelp() {
 	base.RedrawChan <- true
 }
 
+// Stop disables the widget and signals its scheduler to quit. If the scheduler isn't
+// listening it will notice the widget is disabled the next time it wakes up
 func (base *Base) Stop() {
 	base.enabledMutex.Lock()
 	base.enabled = false
 	base.enabledMutex.Unlock()
-	base.quitChan <- true
+
+	select {
+	case base.quitChan <- true:
+	default:
+	}
 }
 
 func (base *Base) String() string {
 	return base.name
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// cacheKey identifies the widget's data in the cache
+func (base *Base) cacheKey() string {
+	return moduleKey(base.commonSettings)
+}
+
+// errorBadge returns the marker that's added to the title of a widget whose most recent
+// refresh failed
+func (base *Base) errorBadge() string {
+	color := base.commonSettings.Colors.BorderTheme.Error
+	if color == "" {
+		color = "red"
+	}
+
+	return fmt.Sprintf("[%s]%s[-]", color, errorSigil)
+}
+
+// staleBadge returns the marker that's added to the title of a widget that's displaying
+// cached data, saying how old it is
+func (base *Base) staleBadge(cachedAt time.Time) string {
+	color := base.commonSettings.Colors.RoleTheme.Muted
+	if color == "" {
+		color = "gray"
+	}
+
+	return fmt.Sprintf("[%s](cached %s)[-]", color, humanize.Time(cachedAt))
+}
+
+// markRefreshed records that the widget has just displayed new data
+func (base *Base) markRefreshed() {
+	base.refreshMutex.Lock()
+	base.refreshedAt = time.Now()
+	base.refreshMutex.Unlock()
+}
+
+// moduleKey identifies a module in the cache and the UI state. Names are only unique
+// within a scope, so the scope, which starts with the path of the dashboard's config file,
+// keeps the modules of different dashboards, and those inside groups, apart
+func moduleKey(commonSettings *cfg.Common) string {
+	return commonSettings.Scope + "/" + commonSettings.Type + "-" + commonSettings.Name
+}
@@ -1,6 +1,7 @@
 package view
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/rivo/tview"
@@ -74,3 +75,137 @@ func Test_ContextualTitle(t *testing.T) {
 		})
 	}
 }
+
+func Test_PauseResume(t *testing.T) {
+	base := NewBase(
+		tview.NewApplication(),
+		make(chan bool),
+		tview.NewPages(),
+		&cfg.Common{},
+	)
+
+	assert.False(t, base.Paused())
+
+	base.Pause()
+	assert.True(t, base.Paused())
+
+	base.Resume()
+	assert.False(t, base.Paused())
+}
+
+func Test_SetRefreshError(t *testing.T) {
+	base := NewBase(
+		tview.NewApplication(),
+		make(chan bool),
+		tview.NewPages(),
+		&cfg.Common{},
+	)
+
+	base.SetRefreshError(errors.New("timeout"))
+	base.SetRefreshError(errors.New("timeout"))
+
+	assert.EqualError(t, base.RefreshError(), "timeout")
+	assert.Equal(t, 2, base.ConsecutiveFailures())
+
+	base.SetRefreshError(nil)
+
+	assert.NoError(t, base.RefreshError())
+	assert.Equal(t, 0, base.ConsecutiveFailures())
+}
+
+func Test_ErrorDecorations(t *testing.T) {
+	base := NewBase(
+		tview.NewApplication(),
+		make(chan bool),
+		tview.NewPages(),
+		&cfg.Common{},
+	)
+	base.commonSettings.Colors.BorderTheme.Error = "red"
+	base.commonSettings.Colors.BorderTheme.Unfocusable = "gray"
+
+	assert.Equal(t, " Jira ", base.ContextualTitle("Jira"))
+	assert.Equal(t, "gray", base.BorderColor())
+
+	base.SetRefreshError(errors.New("timeout"))
+
+	assert.Equal(t, " [red]✘[-] Jira ", base.ContextualTitle("Jira"))
+	assert.Equal(t, "red", base.BorderColor())
+	assert.True(t, base.LastSuccessAt().IsZero())
+
+	base.SetRefreshError(nil)
+
+	assert.Equal(t, " Jira ", base.ContextualTitle("Jira"))
+	assert.False(t, base.LastSuccessAt().IsZero())
+}
+
+func Test_Cache(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	newBase := func(cache bool) *Base {
+		return NewBase(
+			tview.NewApplication(),
+			make(chan bool),
+			tview.NewPages(),
+			&cfg.Common{Module: cfg.Module{Name: "builds", Type: "jenkins"}, Cache: cache},
+		)
+	}
+
+	data := []string{}
+
+	base := newBase(true)
+	assert.False(t, base.LoadCache(&data))
+
+	base.SaveCache([]string{"api", "web"})
+
+	base = newBase(true)
+	assert.True(t, base.LoadCache(&data))
+	assert.Equal(t, []string{"api", "web"}, data)
+	assert.False(t, base.CachedAt().IsZero())
+	assert.Equal(t, " Jenkins [gray](cached now)[-] ", base.ContextualTitle("Jenkins"))
+
+	base.SetRefreshError(errors.New("timeout"))
+	assert.False(t, base.CachedAt().IsZero())
+
+	base.SetRefreshError(nil)
+	assert.True(t, base.CachedAt().IsZero())
+	assert.Equal(t, " Jenkins ", base.ContextualTitle("Jenkins"))
+
+	assert.False(t, newBase(false).LoadCache(&data))
+
+	// Modules with the same name in different scopes have caches of their own
+	other := newBase(true)
+	other.CommonSettings().SetScope("/work/config.yml")
+	assert.False(t, other.LoadCache(&data))
+}
+
+func Test_State(t *testing.T) {
+	newBase := func(scope string) *Base {
+		return NewBase(
+			tview.NewApplication(),
+			make(chan bool),
+			tview.NewPages(),
+			&cfg.Common{Module: cfg.Module{Name: "tasks", Type: "todo"}, Scope: scope},
+		)
+	}
+
+	newBase("/home/config.yml").SaveState("selected", 3)
+
+	selected := 0
+	assert.True(t, newBase("/home/config.yml").LoadState("selected", &selected))
+	assert.Equal(t, 3, selected)
+
+	// The same module on another dashboard has a state of its own
+	assert.False(t, newBase("/work/config.yml").LoadState("selected", &selected))
+}
+
+func Test_QueueUpdate(t *testing.T) {
+	tviewApp := tview.NewApplication()
+	RunUpdatesDirectly(tviewApp)
+
+	base := NewBase(tviewApp, make(chan bool), tview.NewPages(), &cfg.Common{})
+
+	updated := false
+	base.QueueUpdateDraw(func() { updated = true })
+
+	assert.True(t, updated)
+}
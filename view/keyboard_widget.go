@@ -5,7 +5,9 @@ import (
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -13,20 +15,40 @@ import (
 const helpKeyChar = "/"
 const refreshKeyChar = "r"
 
-type helpItem struct {
-	Key  string
-	Text string
+// The names of the actions every keyboard widget has, for rebinding them in the config
+const (
+	docsAction    = "docs"
+	helpAction    = "help"
+	refreshAction = "refresh"
+)
+
+// boundKey is a single key that triggers a keyboard command
+type boundKey struct {
+	char rune
+	key  tcell.Key
+	name string
+}
+
+// keyBinding is a keyboard command and the keys that trigger it
+type keyBinding struct {
+	action   string
+	fn       func()
+	helpText string
+	keys     []string
+	remapped bool
 }
 
 // KeyboardWidget manages keyboard control for a widget
 type KeyboardWidget struct {
 	settings *cfg.Common
 
-	charMap  map[string]func()
-	keyMap   map[tcell.Key]func()
-	charHelp []helpItem
-	keyHelp  []helpItem
-	maxKey   int
+	bindings  []*keyBinding
+	charMap   map[string]func()
+	conflicts []string
+	keyMap    map[tcell.Key]func()
+	maxKey    int
+	openFn    func()
+	owners    map[string]*keyBinding
 }
 
 // NewKeyboardWidget creates and returns a new instance of KeyboardWidget
@@ -34,10 +56,10 @@ type KeyboardWidget struct {
 func NewKeyboardWidget(settings *cfg.Common) *KeyboardWidget {
 	keyWidget := &KeyboardWidget{
 		settings: settings,
+		bindings: []*keyBinding{},
 		charMap:  make(map[string]func()),
 		keyMap:   make(map[tcell.Key]func()),
-		charHelp: []helpItem{},
-		keyHelp:  []helpItem{},
+		owners:   make(map[string]*keyBinding),
 	}
 
 	keyWidget.initializeCommonKeyboardControls()
@@ -45,7 +67,24 @@ func NewKeyboardWidget(settings *cfg.Common) *KeyboardWidget {
 	return keyWidget
 }
 
-/* -------------------- Exported Functions --------------------- */
+/* -------------------- Exported Functions -------------------- */
+
+// Actions returns the widget's keyboard commands in the order they were added, along with
+// the keys they're currently bound to
+func (widget *KeyboardWidget) Actions() []wtf.KeyboardAction {
+	actions := []wtf.KeyboardAction{}
+
+	for _, binding := range widget.bindings {
+		actions = append(actions, wtf.KeyboardAction{
+			Action: binding.action,
+			Help:   binding.helpText,
+			Keys:   append([]string{}, binding.keys...),
+			Run:    binding.fn,
+		})
+	}
+
+	return actions
+}
 
 // AssignedChars returns a list of all the text characters assigned to an operation
 func (widget *KeyboardWidget) AssignedChars() []string {
@@ -58,19 +97,48 @@ func (widget *KeyboardWidget) AssignedChars() []string {
 	return chars
 }
 
-// HelpText returns the help text and keyboard command info for this widget
+// Conflicts describes the keys that were bound to more than one keyboard command, and
+// the keys in the config that couldn't be bound at all
+func (widget *KeyboardWidget) Conflicts() []string {
+	return widget.conflicts
+}
+
+// HelpText returns the help text and keyboard command info for this widget. Each command
+// is listed with the keys it's currently bound to and the action name that rebinds it
 func (widget *KeyboardWidget) HelpText() string {
 	c := cases.Title(language.English)
-	str := " [green::b]Keyboard commands for " + c.String(widget.settings.Module.Type) + "[white]\n\n"
-
-	for _, item := range widget.charHelp {
-		str += fmt.Sprintf("  %s\t%s\n", item.Key, item.Text)
+	headingColor := widget.settings.Colors.TextTheme.Title
+	str := fmt.Sprintf(" [%s::b]Keyboard commands for %s[-::-]\n\n", headingColor, c.String(widget.settings.Module.Type))
+
+	keyLines := ""
+	unbound := ""
+
+	for _, binding := range widget.bindings {
+		if len(binding.keys) == 0 {
+			unbound += fmt.Sprintf("  %-*s\t%s [::d]%s[::-]\n", widget.maxKey, "-", binding.helpText, binding.action)
+			continue
+		}
+
+		for _, name := range binding.keys {
+			if len([]rune(name)) == 1 {
+				str += fmt.Sprintf("  %s\t%s [::d]%s[::-]\n", name, binding.helpText, binding.action)
+			} else {
+				keyLines += fmt.Sprintf("  %-*s\t%s [::d]%s[::-]\n", widget.maxKey, name, binding.helpText, binding.action)
+			}
+		}
 	}
 
-	str += "\n\n"
+	str += "\n\n" + keyLines
+
+	if unbound != "" {
+		str += fmt.Sprintf("\n\n [%s::b]Unbound[-::-]\n\n", headingColor) + unbound
+	}
 
-	for _, item := range widget.keyHelp {
-		str += fmt.Sprintf("  %-*s\t%s\n", widget.maxKey, item.Key, item.Text)
+	if len(widget.conflicts) > 0 {
+		str += fmt.Sprintf("\n\n [%s::b]Key conflicts[-::-]\n\n", widget.settings.Colors.RoleTheme.Error)
+		for _, conflict := range widget.conflicts {
+			str += fmt.Sprintf("  %s\n", conflict)
+		}
 	}
 
 	return str
@@ -80,7 +148,7 @@ func (widget *KeyboardWidget) HelpText() string {
 // common help text key value
 func (widget *KeyboardWidget) InitializeHelpTextKeyboardControl(helpFunc func()) {
 	if helpFunc != nil {
-		widget.SetKeyboardChar(helpKeyChar, helpFunc, "Show/hide this help prompt")
+		widget.bind(helpAction, "Show/hide this help prompt", helpFunc, []boundKey{charKey(helpKeyChar)})
 	}
 }
 
@@ -88,7 +156,7 @@ func (widget *KeyboardWidget) InitializeHelpTextKeyboardControl(helpFunc func())
 // the commom refresh key value
 func (widget *KeyboardWidget) InitializeRefreshKeyboardControl(refreshFunc func()) {
 	if refreshFunc != nil {
-		widget.SetKeyboardChar(refreshKeyChar, refreshFunc, "Refresh widget")
+		widget.bind(refreshAction, "Refresh widget", refreshFunc, []boundKey{charKey(refreshKeyChar)})
 	}
 }
 
@@ -127,7 +195,15 @@ func (widget *KeyboardWidget) LaunchDocumentation() {
 	utils.OpenFile(url)
 }
 
-// SetKeyboardChar sets a character/function combination that responds to key presses
+// OpenAction returns the keyboard command that opens the selected item, which is the one
+// bound to Enter by default, or nil if there isn't one
+func (widget *KeyboardWidget) OpenAction() func() {
+	return widget.openFn
+}
+
+// SetKeyboardChar sets a character/function combination that responds to key presses.
+// The command can be rebound in the config using the action name derived from its help
+// text, see cfg.ActionName
 // Example:
 //
 //	widget.SetKeyboardChar("d", widget.deleteSelectedItem)
@@ -136,32 +212,154 @@ func (widget *KeyboardWidget) SetKeyboardChar(char string, fn func(), helpText s
 		return
 	}
 
-	// Check to ensure that the key trying to be used isn't already being used for something
-	if _, ok := widget.charMap[char]; ok {
-		panic(fmt.Sprintf("Key is already mapped to a keyboard command: %s\n", char))
-	}
-
-	widget.charMap[char] = fn
-	widget.charHelp = append(widget.charHelp, helpItem{char, helpText})
+	widget.bind(cfg.ActionName(helpText), helpText, fn, []boundKey{charKey(char)})
 }
 
-// SetKeyboardKey sets a tcell.Key/function combination that responds to key presses
+// SetKeyboardKey sets a tcell.Key/function combination that responds to key presses.
+// The command can be rebound in the config using the action name derived from its help
+// text, see cfg.ActionName
 // Example:
 //
 //	widget.SetKeyboardKey(tcell.KeyCtrlD, widget.deleteSelectedItem)
 func (widget *KeyboardWidget) SetKeyboardKey(key tcell.Key, fn func(), helpText string) {
-	widget.keyMap[key] = fn
-	widget.keyHelp = append(widget.keyHelp, helpItem{tcell.KeyNames[key], helpText})
+	name, ok := tcell.KeyNames[key]
+	if !ok {
+		name = fmt.Sprintf("Key[%d]", key)
+	}
+
+	widget.bind(cfg.ActionName(helpText), helpText, fn, []boundKey{{key: key, name: name}})
 
-	if len(tcell.KeyNames[key]) > widget.maxKey {
-		widget.maxKey = len(tcell.KeyNames[key])
+	if key == tcell.KeyEnter && widget.openFn == nil {
+		widget.openFn = fn
 	}
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
+// bind assigns a keyboard command to the keys the user has rebound its action to, or to
+// its default keys if they haven't. A key that's already taken is a conflict. Keys the
+// user chose win over default keys, otherwise the first command bound to a key keeps it.
+// Commands that share an action name, such as a character and a special key with the same
+// help text, are one binding with the default keys of both
+func (widget *KeyboardWidget) bind(action, helpText string, fn func(), defaults []boundKey) {
+	if binding := widget.binding(action); binding != nil {
+		// The user's keys replace every default key of a rebound action
+		if !binding.remapped {
+			widget.assign(binding, fn, defaults)
+		}
+		return
+	}
+
+	binding := &keyBinding{
+		action:   action,
+		fn:       fn,
+		helpText: helpText,
+		keys:     []string{},
+	}
+	widget.bindings = append(widget.bindings, binding)
+
+	keys := defaults
+	if names, ok := widget.settings.Keys.Keys(action); ok {
+		binding.remapped = true
+		keys = widget.parseKeys(action, names)
+	}
+
+	widget.assign(binding, fn, keys)
+}
+
+// assign binds each of the keys to the binding, running fn when they're pressed
+func (widget *KeyboardWidget) assign(binding *keyBinding, fn func(), keys []boundKey) {
+	for _, key := range keys {
+		if owner, taken := widget.owners[key.name]; taken {
+			if owner == binding {
+				continue
+			}
+
+			replace := binding.remapped && !owner.remapped
+
+			keeper := owner.action
+			if replace {
+				keeper = binding.action
+			}
+			widget.conflict(fmt.Sprintf("%s is bound to both %s and %s, %s keeps it", key.name, owner.action, binding.action, keeper))
+
+			if !replace {
+				continue
+			}
+
+			owner.keys = removeKeyName(owner.keys, key.name)
+		}
+
+		widget.owners[key.name] = binding
+		binding.keys = append(binding.keys, key.name)
+
+		if key.char != 0 {
+			widget.charMap[string(key.char)] = fn
+		} else {
+			widget.keyMap[key.key] = fn
+		}
+
+		if len(key.name) > widget.maxKey {
+			widget.maxKey = len(key.name)
+		}
+	}
+}
+
+// binding returns the keyboard command with the given action name, or nil if there
+// isn't one
+func (widget *KeyboardWidget) binding(action string) *keyBinding {
+	for _, binding := range widget.bindings {
+		if binding.action == action {
+			return binding
+		}
+	}
+
+	return nil
+}
+
+// conflict records a key binding problem, which is logged and shown in the help text
+func (widget *KeyboardWidget) conflict(msg string) {
+	widget.conflicts = append(widget.conflicts, msg)
+	logger.Module(widget.settings.Name).Warn(msg)
+}
+
 // initializeCommonKeyboardControls sets up the keyboard controls that are common to
 // all widgets that accept keyboard input
 func (widget *KeyboardWidget) initializeCommonKeyboardControls() {
-	widget.SetKeyboardChar("\\", widget.LaunchDocumentation, "Open the documentation for this module in a browser")
+	widget.bind(docsAction, "Open the documentation for this module in a browser", widget.LaunchDocumentation, []boundKey{charKey("\\")})
+}
+
+// parseKeys turns the key names the user rebound an action to into keys
+func (widget *KeyboardWidget) parseKeys(action string, names []string) []boundKey {
+	keys := []boundKey{}
+
+	for _, name := range names {
+		key, char, canonical, err := cfg.ParseKey(name)
+		if err != nil {
+			widget.conflict(fmt.Sprintf("%s cannot be bound to %s: %s", action, name, err))
+			continue
+		}
+
+		keys = append(keys, boundKey{char: char, key: key, name: canonical})
+	}
+
+	return keys
+}
+
+// charKey returns the boundKey for a single character
+func charKey(char string) boundKey {
+	return boundKey{char: []rune(char)[0], key: tcell.KeyRune, name: char}
+}
+
+// removeKeyName returns the list of key names without the given name
+func removeKeyName(names []string, name string) []string {
+	kept := []string{}
+
+	for _, existing := range names {
+		if existing != name {
+			kept = append(kept, existing)
+		}
+	}
+
+	return kept
 }
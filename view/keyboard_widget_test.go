@@ -195,6 +195,82 @@ func Test_InitializeRefreshKeyboardControl(t *testing.T) {
 	})
 }
 
+func Test_KeyBindings(t *testing.T) {
+	keyWid := NewKeyboardWidget(
+		&cfg.Common{
+			Keys: cfg.KeyBindings{
+				"select-next-item":     {"n", "Down"},
+				"select-previous-item": {"j"},
+				"refresh":              {},
+				"delete-item":          {"Hyper-X"},
+			},
+			Module: cfg.Module{
+				Name: "testWidget",
+				Type: "testType",
+			},
+		},
+	)
+
+	keyWid.InitializeRefreshKeyboardControl(test)
+	keyWid.SetKeyboardChar("j", test, "Select next item")
+	keyWid.SetKeyboardChar("k", test, "Select previous item")
+	keyWid.SetKeyboardChar("n", test, "New item")
+	keyWid.SetKeyboardChar("d", test, "Delete item")
+
+	// Rebound actions use their new keys instead of their defaults
+	assert.NotNil(t, keyWid.charMap["n"])
+	assert.NotNil(t, keyWid.keyMap[tcell.KeyDown])
+	assert.NotNil(t, keyWid.charMap["j"])
+	assert.Nil(t, keyWid.charMap["k"])
+	assert.Nil(t, keyWid.charMap["r"])
+	assert.Nil(t, keyWid.charMap["d"])
+
+	// A default key that's been rebound elsewhere stays with the rebound action
+	assert.Equal(t, []string{"n", "Down"}, keyWid.bindings[2].keys)
+	assert.Equal(t, []string{}, keyWid.bindings[4].keys)
+
+	assert.Equal(
+		t,
+		[]string{
+			"n is bound to both select-next-item and new-item, select-next-item keeps it",
+			"delete-item cannot be bound to Hyper-X: unknown key: Hyper-X",
+		},
+		keyWid.Conflicts(),
+	)
+}
+
+func Test_KeyBindings_sharedAction(t *testing.T) {
+	keyWid := NewKeyboardWidget(
+		&cfg.Common{
+			Keys: cfg.KeyBindings{
+				"select-previous-item": {"p"},
+			},
+			Module: cfg.Module{
+				Name: "testWidget",
+				Type: "testType",
+			},
+		},
+	)
+
+	keyWid.SetKeyboardChar("j", test, "Select next item")
+	keyWid.SetKeyboardKey(tcell.KeyDown, test, "Select next item")
+	keyWid.SetKeyboardChar("k", test, "Select previous item")
+	keyWid.SetKeyboardKey(tcell.KeyUp, test, "Select previous item")
+
+	// A char and a key with the same help text are a single action with both keys
+	actions := keyWid.Actions()
+	assert.Equal(t, 3, len(actions))
+	assert.Equal(t, "select-next-item", actions[1].Action)
+	assert.Equal(t, []string{"j", "Down"}, actions[1].Keys)
+
+	// Rebinding the action replaces both its default keys, without conflicting with itself
+	assert.Equal(t, "select-previous-item", actions[2].Action)
+	assert.Equal(t, []string{"p"}, actions[2].Keys)
+	assert.Nil(t, keyWid.charMap["k"])
+	assert.Nil(t, keyWid.keyMap[tcell.KeyUp])
+	assert.Empty(t, keyWid.Conflicts())
+}
+
 func Test_HelpText(t *testing.T) {
 	keyWid := testKeyboardWidget()
 	keyWid.SetKeyboardChar("a", test, "a help")
@@ -2,9 +2,13 @@ package view
 
 import (
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/state"
 	"github.com/wtfutil/wtf/utils"
 )
 
+// sourceStateKey names the displayed source in the widget's UI state
+const sourceStateKey = "source"
+
 // MultiSourceWidget is a widget that supports displaying data from multiple sources
 type MultiSourceWidget struct {
 	moduleConfig *cfg.Common
@@ -25,6 +29,7 @@ func NewMultiSourceWidget(moduleConfig *cfg.Common, singular, plural string) Mul
 	}
 
 	widget.loadSources()
+	widget.restoreSource()
 
 	return widget
 }
@@ -48,6 +53,8 @@ func (widget *MultiSourceWidget) NextSource() {
 		widget.Idx = 0
 	}
 
+	widget.saveSource()
+
 	if widget.DisplayFunction != nil {
 		widget.DisplayFunction()
 	}
@@ -61,6 +68,8 @@ func (widget *MultiSourceWidget) PrevSource() {
 		widget.Idx = len(widget.Sources) - 1
 	}
 
+	widget.saveSource()
+
 	if widget.DisplayFunction != nil {
 		widget.DisplayFunction()
 	}
@@ -97,3 +106,23 @@ func (widget *MultiSourceWidget) loadSources() {
 
 	widget.Sources = asStrs
 }
+
+// restoreSource displays the source that was displayed before the last restart, if it's
+// still one of the sources
+func (widget *MultiSourceWidget) restoreSource() {
+	source := ""
+	if !state.Load(moduleKey(widget.moduleConfig), sourceStateKey, &source) {
+		return
+	}
+
+	for idx, candidate := range widget.Sources {
+		if candidate == source {
+			widget.Idx = idx
+			return
+		}
+	}
+}
+
+func (widget *MultiSourceWidget) saveSource() {
+	state.Save(moduleKey(widget.moduleConfig), sourceStateKey, widget.CurrentSource())
+}
@@ -1,18 +1,31 @@
 package view
 
 import (
+	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
 )
 
+// rowRegion matches the region tag a row of a scrollable widget starts with
+var rowRegion = regexp.MustCompile(`\["(\d+)"\]`)
+
+// selectedStateKey names the selected item in the widget's UI state
+const selectedStateKey = "selected"
+
 type ScrollableWidget struct {
 	TextWidget
 
 	Selected       int
 	maxItems       int
 	RenderFunction func()
+
+	// restoreSelected is the selection saved before the last restart, restored once the
+	// widget has items to select, or -1
+	restoreSelected int
 }
 
 func NewScrollableWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, commonSettings *cfg.Common) ScrollableWidget {
@@ -24,19 +37,36 @@ func NewScrollableWidget(tviewApp *tview.Application, redrawChan chan bool, page
 	widget.View.SetScrollable(true)
 	widget.View.SetRegions(true)
 
+	widget.restoreSelected = -1
+	widget.LoadState(selectedStateKey, &widget.restoreSelected)
+
 	return widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
+// SetRenderFunction sets the function that displays the widget's items. It also lets the
+// mouse select them, which has to wait until now because the mouse handler needs the
+// widget the module embeds, not the copy NewScrollableWidget returned
 func (widget *ScrollableWidget) SetRenderFunction(displayFunc func()) {
 	widget.RenderFunction = displayFunc
+
+	widget.View.SetMouseCapture(widget.mouseCapture)
 }
 
 func (widget *ScrollableWidget) SetItemCount(items int) {
 	widget.maxItems = items
 	if items == 0 {
 		widget.Selected = -1
+		return
+	}
+
+	if widget.restoreSelected >= 0 {
+		widget.Selected = widget.restoreSelected
+		if widget.Selected >= items {
+			widget.Selected = items - 1
+		}
+		widget.restoreSelected = -1
 	}
 }
 
@@ -86,4 +116,69 @@ func (widget *ScrollableWidget) Redraw(data func() (string, string, bool)) {
 
 	widget.View.Highlight(strconv.Itoa(widget.Selected))
 	widget.View.ScrollToHighlight()
+
+	// Until the saved selection's been restored, saving would overwrite it
+	if widget.restoreSelected < 0 {
+		widget.SaveState(selectedStateKey, widget.Selected)
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// itemAt returns the index of the item on the given screen row, or -1 if there's none.
+// Rows are recognized by the region named after their index that they start with, see
+// utils.HighlightableHelper, so this assumes the widget's text isn't wrapped
+func (widget *ScrollableWidget) itemAt(y int) int {
+	_, innerY, _, innerHeight := widget.View.GetInnerRect()
+	if y < innerY || y >= innerY+innerHeight {
+		return -1
+	}
+
+	// The offset is only known once the view's been drawn, until then it's at the top
+	offset, _ := widget.View.GetScrollOffset()
+	if offset < 0 {
+		offset = 0
+	}
+	line := offset + y - innerY
+
+	lines := strings.Split(widget.View.GetText(false), "\n")
+	if line < 0 || line >= len(lines) {
+		return -1
+	}
+
+	match := rowRegion.FindStringSubmatch(lines[line])
+	if match == nil {
+		return -1
+	}
+
+	idx, err := strconv.Atoi(match[1])
+	if err != nil || idx >= widget.maxItems {
+		return -1
+	}
+
+	return idx
+}
+
+// mouseCapture selects the item that's clicked on, and opens it when it's double-clicked.
+// The first click of a double-click has already selected it
+func (widget *ScrollableWidget) mouseCapture(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	x, y := event.Position()
+	if !widget.View.InRect(x, y) {
+		return action, event
+	}
+
+	switch action {
+	case tview.MouseLeftClick:
+		if idx := widget.itemAt(y); idx >= 0 && idx != widget.Selected {
+			widget.Selected = idx
+			widget.RenderFunction()
+		}
+	case tview.MouseLeftDoubleClick:
+		if open := widget.OpenAction(); open != nil && widget.Selected >= 0 {
+			open()
+		}
+		return action, nil
+	}
+
+	return action, event
 }
@@ -0,0 +1,134 @@
+package view
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/state"
+)
+
+func testScrollableWidget() *ScrollableWidget {
+	widget := NewScrollableWidget(
+		tview.NewApplication(),
+		make(chan bool),
+		tview.NewPages(),
+		&cfg.Common{
+			Module: cfg.Module{
+				Name: "test widget",
+			},
+		},
+	)
+
+	return &widget
+}
+
+func Test_ScrollableWidget_mouseCapture(t *testing.T) {
+	widget := testScrollableWidget()
+
+	renders := 0
+	widget.SetRenderFunction(func() { renders++ })
+
+	opened := -1
+	widget.SetKeyboardKey(tcell.KeyEnter, func() { opened = widget.Selected }, "Open item")
+
+	widget.SetItemCount(3)
+	widget.View.SetText("Header\n[\"0\"][\"\"]first[\"\"]\n[\"1\"][\"\"]second[\"\"]\n[\"2\"][\"\"]third[\"\"]")
+
+	// With a border, the text starts one row and one column in
+	widget.View.SetBorder(true)
+	widget.View.SetRect(0, 0, 20, 6)
+
+	click := func(action tview.MouseAction, y int) *tcell.EventMouse {
+		_, event := widget.mouseCapture(action, tcell.NewEventMouse(2, y, tcell.Button1, tcell.ModNone))
+		return event
+	}
+
+	t.Run("on the header", func(t *testing.T) {
+		click(tview.MouseLeftClick, 1)
+
+		assert.Equal(t, -1, widget.Selected)
+		assert.Equal(t, 0, renders)
+	})
+
+	t.Run("on a row", func(t *testing.T) {
+		event := click(tview.MouseLeftClick, 3)
+
+		assert.NotNil(t, event)
+		assert.Equal(t, 1, widget.Selected)
+		assert.Equal(t, 1, renders)
+	})
+
+	t.Run("outside the widget", func(t *testing.T) {
+		click(tview.MouseLeftClick, 10)
+
+		assert.Equal(t, 1, widget.Selected)
+	})
+
+	t.Run("double-clicking a row", func(t *testing.T) {
+		event := click(tview.MouseLeftDoubleClick, 3)
+
+		assert.Nil(t, event)
+		assert.Equal(t, 1, opened)
+	})
+}
+
+func Test_OpenAction(t *testing.T) {
+	keyWid := testKeyboardWidget()
+	assert.Nil(t, keyWid.OpenAction())
+
+	opened := false
+	keyWid.SetKeyboardKey(tcell.KeyEnter, func() { opened = true }, "Open item")
+	keyWid.SetKeyboardKey(tcell.KeyEnter, test, "Open something else")
+
+	keyWid.OpenAction()()
+	assert.True(t, opened)
+}
+
+func Test_ScrollableWidget_restoreSelected(t *testing.T) {
+	state.Configure(filepath.Join(t.TempDir(), "state.json"))
+	defer state.Configure("")
+
+	newWidget := func() *ScrollableWidget {
+		widget := NewScrollableWidget(
+			tview.NewApplication(),
+			make(chan bool, 10),
+			tview.NewPages(),
+			&cfg.Common{Module: cfg.Module{Name: "jira", Type: "jira"}},
+		)
+		widget.SetRenderFunction(func() {
+			widget.Redraw(func() (string, string, bool) { return "jira", "", false })
+		})
+
+		return &widget
+	}
+
+	widget := newWidget()
+	widget.SetItemCount(5)
+	widget.Next()
+	widget.Next()
+	widget.Next()
+	assert.Equal(t, 2, widget.Selected)
+
+	t.Run("with as many items", func(t *testing.T) {
+		restored := newWidget()
+		assert.Equal(t, -1, restored.Selected)
+
+		// The selection waits for the items to arrive
+		restored.SetItemCount(0)
+		restored.RenderFunction()
+		assert.Equal(t, -1, restored.Selected)
+
+		restored.SetItemCount(5)
+		assert.Equal(t, 2, restored.Selected)
+	})
+
+	t.Run("with fewer items", func(t *testing.T) {
+		restored := newWidget()
+		restored.SetItemCount(2)
+		assert.Equal(t, 1, restored.Selected)
+	})
+}
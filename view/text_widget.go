@@ -48,6 +48,12 @@ func (widget *TextWidget) Redraw(data func() (string, string, bool)) {
 	widget.View.SetTitle(widget.ContextualTitle(title))
 	widget.View.SetText(strings.TrimRight(content, "\n"))
 
+	// Focused widgets keep their focus border, everyone else shows their refresh state
+	if !widget.View.HasFocus() {
+		widget.View.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
+	}
+
+	widget.markRefreshed()
 	widget.RedrawChan <- true
 }
 
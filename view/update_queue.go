@@ -0,0 +1,63 @@
+package view
+
+import (
+	"sync"
+
+	"github.com/rivo/tview"
+)
+
+// directApps are the applications that are never run, mapped to the mutex that keeps
+// the updates queued for them from running at the same time
+var (
+	directApps      = map[*tview.Application]*sync.Mutex{}
+	directAppsMutex = &sync.Mutex{}
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// RunUpdatesDirectly makes widgets created with the given application run the updates they
+// queue right away, instead of waiting for the application's event loop to run them. It's
+// for the modes that never run the application, such as headless mode and snapshots
+func RunUpdatesDirectly(tviewApp *tview.Application) {
+	directAppsMutex.Lock()
+	defer directAppsMutex.Unlock()
+
+	if _, ok := directApps[tviewApp]; !ok {
+		directApps[tviewApp] = &sync.Mutex{}
+	}
+}
+
+// QueueUpdate runs the function on the application's event loop, where it's safe to change
+// what's onscreen, and waits for it to finish
+func (base *Base) QueueUpdate(f func()) {
+	if mutex := directMutex(base.tviewApp); mutex != nil {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		f()
+		return
+	}
+
+	base.tviewApp.QueueUpdate(f)
+}
+
+// QueueUpdateDraw works like QueueUpdate, and then redraws the screen
+func (base *Base) QueueUpdateDraw(f func()) {
+	if directMutex(base.tviewApp) != nil {
+		base.QueueUpdate(f)
+		return
+	}
+
+	base.tviewApp.QueueUpdateDraw(f)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// directMutex returns the mutex updates for the application run under if it's never run,
+// or nil if it is
+func directMutex(tviewApp *tview.Application) *sync.Mutex {
+	directAppsMutex.Lock()
+	defer directAppsMutex.Unlock()
+
+	return directApps[tviewApp]
+}
@@ -0,0 +1,16 @@
+package wtf
+
+// KeyboardAction is a keyboard command a widget responds to
+type KeyboardAction struct {
+	// Action is the name used to rebind the command in the config
+	Action string
+	Help   string
+	Keys   []string
+	Run    func()
+}
+
+// Actionable is the interface implemented by widgets that accept keyboard commands, so
+// that their commands can be run without knowing which keys they're bound to
+type Actionable interface {
+	Actions() []KeyboardAction
+}
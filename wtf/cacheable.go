@@ -0,0 +1,11 @@
+package wtf
+
+import "time"
+
+// Cacheable is the interface implemented by modules that display the data they cached
+// the last time they refreshed successfully, until they refresh again. RenderCached is
+// called before the module's first refresh
+type Cacheable interface {
+	CachedAt() time.Time
+	RenderCached()
+}
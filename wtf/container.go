@@ -0,0 +1,7 @@
+package wtf
+
+// Container is the interface implemented by widgets that display other widgets. The
+// widgets inside it are scheduled like any other, but displayed by the container
+type Container interface {
+	Children() []Wtfable
+}
@@ -0,0 +1,74 @@
+package wtf
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+)
+
+// ModuleDefinition describes a type of module: how to create its settings and its widget,
+// and where its documentation lives
+type ModuleDefinition struct {
+	// DocPath is the path of the module's documentation, relative to https://wtfutil.com/modules/
+	DocPath string
+
+	// NewSettings creates the module's settings from its configuration
+	NewSettings func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{}
+
+	// NewWidget creates the module's widget from the settings created by NewSettings
+	NewWidget func(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings interface{}) Wtfable
+
+	// Type is the value of the `type` key that selects this module in the config file
+	Type string
+}
+
+var (
+	moduleDefinitions = map[string]ModuleDefinition{}
+	moduleMutex       = &sync.RWMutex{}
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// RegisterModule makes a type of module available to the app. Modules register themselves
+// from an init() function in their package, so a module is included in the build by
+// importing its package. Registering the same type twice is a programming error and panics
+func RegisterModule(definition ModuleDefinition) {
+	if definition.Type == "" || definition.NewSettings == nil || definition.NewWidget == nil {
+		panic(fmt.Sprintf("wtf: incomplete definition for module %q", definition.Type))
+	}
+
+	moduleMutex.Lock()
+	defer moduleMutex.Unlock()
+
+	if _, exists := moduleDefinitions[definition.Type]; exists {
+		panic(fmt.Sprintf("wtf: module %q is already registered", definition.Type))
+	}
+
+	moduleDefinitions[definition.Type] = definition
+}
+
+// LookupModule returns the definition of the given type of module, if it's registered
+func LookupModule(moduleType string) (ModuleDefinition, bool) {
+	moduleMutex.RLock()
+	defer moduleMutex.RUnlock()
+
+	definition, ok := moduleDefinitions[moduleType]
+	return definition, ok
+}
+
+// ModuleTypes returns the types of all the registered modules, in alphabetical order
+func ModuleTypes() []string {
+	moduleMutex.RLock()
+	defer moduleMutex.RUnlock()
+
+	moduleTypes := make([]string, 0, len(moduleDefinitions))
+	for moduleType := range moduleDefinitions {
+		moduleTypes = append(moduleTypes, moduleType)
+	}
+	sort.Strings(moduleTypes)
+
+	return moduleTypes
+}
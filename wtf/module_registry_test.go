@@ -0,0 +1,44 @@
+package wtf
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDefinition(moduleType string) ModuleDefinition {
+	return ModuleDefinition{
+		DocPath: "test/" + moduleType,
+		NewSettings: func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+			return name
+		},
+		NewWidget: func(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings interface{}) Wtfable {
+			return nil
+		},
+		Type: moduleType,
+	}
+}
+
+func Test_RegisterModule(t *testing.T) {
+	RegisterModule(testDefinition("registry_test_b"))
+	RegisterModule(testDefinition("registry_test_a"))
+
+	definition, ok := LookupModule("registry_test_a")
+	assert.True(t, ok)
+	assert.Equal(t, "test/registry_test_a", definition.DocPath)
+
+	_, ok = LookupModule("registry_test_missing")
+	assert.False(t, ok)
+
+	assert.Subset(t, ModuleTypes(), []string{"registry_test_a", "registry_test_b"})
+	assert.IsNonDecreasing(t, ModuleTypes())
+}
+
+func Test_RegisterModule_Invalid(t *testing.T) {
+	RegisterModule(testDefinition("registry_test_duplicate"))
+
+	assert.Panics(t, func() { RegisterModule(testDefinition("registry_test_duplicate")) })
+	assert.Panics(t, func() { RegisterModule(ModuleDefinition{Type: "registry_test_incomplete"}) })
+}
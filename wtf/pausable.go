@@ -0,0 +1,9 @@
+package wtf
+
+// Pausable is the interface that enforces pause/resume capabilities on a module.
+// A paused module stays enabled but skips its scheduled refreshes
+type Pausable interface {
+	Pause()
+	Paused() bool
+	Resume()
+}
@@ -0,0 +1,9 @@
+package wtf
+
+// Resizable is the interface implemented by widgets that render their content for a
+// particular size, such as commands told the size of their widget, so that they can
+// render it again when that size changes, for example when the widget is zoomed
+type Resizable interface {
+	// Resize is given the space inside the widget's border, in characters
+	Resize(width, height int)
+}
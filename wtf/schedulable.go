@@ -4,7 +4,11 @@ import "time"
 
 // Schedulable is the interface that enforces scheduling capabilities on a module
 type Schedulable interface {
+	ConsecutiveFailures() int
+	LastSuccessAt() time.Time
 	Refresh()
+	RefreshError() error
 	Refreshing() bool
 	RefreshInterval() time.Duration
+	RefreshedAt() time.Time
 }